@@ -0,0 +1,245 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+// Almanac: Daily tables of Sun and Moon phenomena.
+//
+// This package does not correspond to a chapter of the book.  It combines
+// functions of several chapter packages to produce the sort of daily table
+// printed in an almanac:  rising, transit, and setting of the Sun and Moon,
+// morning and evening twilight, phase and illuminated fraction of the Moon,
+// the equation of time, and sidereal time at 0h UT.
+//
+// The Sun is computed with package solar, the Moon with package moonposition,
+// so no VSOP87 data is needed.  Times are UT and are good to about a minute
+// for the Sun and a few minutes for the Moon.
+package almanac
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
+	"github.com/soniakeys/meeus/v3/deltat"
+	"github.com/soniakeys/meeus/v3/eqtime"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/moonillum"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/nutation"
+	"github.com/soniakeys/meeus/v3/rise"
+	"github.com/soniakeys/meeus/v3/sidereal"
+	"github.com/soniakeys/meeus/v3/solar"
+	"github.com/soniakeys/unit"
+)
+
+// Altitudes of the center of the Sun defining the ends of twilight.
+var (
+	Civil        = unit.AngleFromDeg(-6)
+	Nautical     = unit.AngleFromDeg(-12)
+	Astronomical = unit.AngleFromDeg(-18)
+)
+
+// Day holds one line of an almanac table.
+//
+// Rise, transit, and set times are UT seconds from 0h of the day, computed
+// with rise.Bodies, and are the events of the transit on the day:  Rise
+// precedes and Set follows Transit.  A rising on the day before is negative
+// and a setting on the day after is 86400 or more.  For twilight, Rise is the
+// beginning of morning twilight and Set is the end of evening twilight.  When
+// the body does not cross the altitude on the day, Err is
+// rise.ErrorCircumpolar.
+type Day struct {
+	JD                   float64 // 0h UT of the day
+	Year, Month, Day     int     // calendar date, Julian or Gregorian as appropriate
+	Sun, Moon            rise.RiseSet
+	CivilTwilight        rise.RiseSet
+	NauticalTwilight     rise.RiseSet
+	AstronomicalTwilight rise.RiseSet
+	MoonPhase            unit.Angle     // phase angle of the Moon at 0h UT
+	MoonIlluminated      float64        // illuminated fraction of the Moon at 0h UT
+	MoonWaxing           bool           // true if the illuminated fraction is increasing
+	EqTime               unit.HourAngle // equation of time at 0h UT
+	Sidereal0            unit.Time      // apparent sidereal time at 0h UT
+}
+
+// Table computes almanac lines for days jd1 through jd2, both 0h UT, for an
+// observer at geographic coordinates p.  As throughout the library, p.Lon is
+// positive west; see globe.CoordFromEastLon.
+func Table(jd1, jd2 float64, p globe.Coord) []Day {
+	var t []Day
+	for jd := jd1; jd <= jd2; jd++ {
+		t = append(t, NewDay(jd, p))
+	}
+	return t
+}
+
+// NewDay computes an almanac line for day jd, 0h UT, for an observer at
+// geographic coordinates p.
+func NewDay(jd float64, p globe.Coord) Day {
+	ΔT := deltat.Interp10A(jd)
+	jde := jd + ΔT.Day()
+	d := Day{JD: jd, Sidereal0: sidereal.Apparent0UT(jd)}
+	y, m, df := julian.JDToCalendar(jd)
+	d.Year, d.Month, d.Day = y, m, int(df)
+	// rise.Bodies takes the Gregorian date, which differs from the
+	// calendar date before the Gregorian reform.
+	g := julian.JDToTime(jd)
+	r := rise.Bodies(g.Year(), int(g.Month()), g.Day(), p, []rise.BodyEphemFunc{
+		sunEquatorial(rise.Stdh0Solar),
+		moonEquatorial,
+		sunEquatorial(Civil),
+		sunEquatorial(Nautical),
+		sunEquatorial(Astronomical),
+	})
+	for i, rs := range []*rise.RiseSet{&d.Sun, &d.Moon,
+		&d.CivilTwilight, &d.NauticalTwilight, &d.AstronomicalTwilight} {
+		*rs = unwrap(r[i])
+	}
+	d.MoonPhase = moonillum.PhaseAngle(jde)
+	d.MoonIlluminated = base.Illuminated(d.MoonPhase)
+	// waxing while the Moon is east of the Sun
+	λ, _, _ := moonposition.Position(jde)
+	λ0 := solar.ApparentLongitude(base.J2000Century(jde))
+	d.MoonWaxing = (λ - λ0).Mod1() < math.Pi
+	d.EqTime = eqtime.ESmart(jde)
+	return d
+}
+
+// unwrap returns r with Rise moved before and Set moved after Transit.
+//
+// rise.Bodies returns times of day in [0, 86400), so the setting that
+// follows a transit late in the day is returned as a time early in the
+// day.  After unwrap such an event is at or after 86400, on the day after.
+func unwrap(r rise.RiseSet) rise.RiseSet {
+	if r.Err != nil {
+		return r
+	}
+	if r.Rise > r.Transit {
+		r.Rise -= 86400
+	}
+	if r.Set < r.Transit {
+		r.Set += 86400
+	}
+	return r
+}
+
+// sunEquatorial returns a rise.BodyEphemFunc for the Sun with standard
+// altitude h0.
+func sunEquatorial(h0 unit.Angle) rise.BodyEphemFunc {
+	return func(jde base.JDE) (unit.RA, unit.Angle, unit.Angle) {
+		α, δ := solar.ApparentEquatorial(float64(jde))
+		return α, δ, h0
+	}
+}
+
+// moonEquatorial returns apparent equatorial coordinates and the standard
+// altitude of the Moon.
+func moonEquatorial(j base.JDE) (unit.RA, unit.Angle, unit.Angle) {
+	jde := float64(j)
+	λ, β, Δ := moonposition.Position(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	sε, cε := (nutation.MeanObliquity(jde) + Δε).Sincos()
+	α, δ := coord.EclToEq(λ+Δψ, β, sε, cε)
+	return α, δ, rise.Stdh0Lunar(moonposition.Parallax(Δ))
+}
+
+// Header lists the column names written by WriteCSV, and the names of the
+// fields written by MarshalJSON.
+var Header = []string{"date",
+	"sunrise", "suntransit", "sunset",
+	"moonrise", "moontransit", "moonset",
+	"civildawn", "civildusk",
+	"nauticaldawn", "nauticaldusk",
+	"astronomicaldawn", "astronomicaldusk",
+	"moonphase", "moonilluminated", "moonwaxing",
+	"eqtime", "sidereal0"}
+
+// fields formats d as strings in the order of Header.
+//
+// Dates are ISO style, times of day are hh:mm:ss, the phase angle is in
+// degrees, the equation of time is in minutes, and sidereal time is hh:mm:ss.
+// Events that do not occur on the day are empty strings.
+func (d *Day) fields() []string {
+	f := []string{fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)}
+	rs := func(r *rise.RiseSet, transit bool) {
+		if r.Err != nil {
+			f = append(f, "", "")
+			if transit {
+				f = append(f, "")
+			}
+			return
+		}
+		f = append(f, onDay(r.Rise))
+		if transit {
+			f = append(f, onDay(r.Transit))
+		}
+		f = append(f, onDay(r.Set))
+	}
+	rs(&d.Sun, true)
+	rs(&d.Moon, true)
+	rs(&d.CivilTwilight, false)
+	rs(&d.NauticalTwilight, false)
+	rs(&d.AstronomicalTwilight, false)
+	return append(f,
+		fmt.Sprintf("%.1f", d.MoonPhase.Deg()),
+		fmt.Sprintf("%.3f", d.MoonIlluminated),
+		fmt.Sprint(d.MoonWaxing),
+		fmt.Sprintf("%.2f", d.EqTime.Min()),
+		hms(d.Sidereal0))
+}
+
+// onDay formats t as hms, or as an empty string if t is not on the day.
+func onDay(t unit.Time) string {
+	if t < 0 || t >= 86400 {
+		return ""
+	}
+	return hms(t)
+}
+
+// hms formats a time of day as hh:mm:ss.
+func hms(t unit.Time) string {
+	s := int(math.Floor(t.Sec()+.5)) % 86400
+	if s < 0 {
+		s += 86400
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+// WriteCSV writes table t to w as comma separated values, preceded by
+// a line of Header.  Fields are formatted as described for MarshalJSON.
+func WriteCSV(w io.Writer, t []Day) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(Header); err != nil {
+		return err
+	}
+	for i := range t {
+		if err := cw.Write(t[i].fields()); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// MarshalJSON encodes d as a JSON object with the names of Header.
+//
+// Values are strings formatted for display:  dates are yyyy-mm-dd, times
+// of day are hh:mm:ss UT, the Moon phase angle is in degrees, the equation
+// of time is in minutes, and sidereal time is hh:mm:ss.  Events that do not
+// occur on the day are null.
+func (d Day) MarshalJSON() ([]byte, error) {
+	f := d.fields()
+	m := make(map[string]interface{}, len(f))
+	for i, s := range f {
+		if s == "" {
+			m[Header[i]] = nil
+		} else {
+			m[Header[i]] = s
+		}
+	}
+	return json.Marshal(m)
+}
@@ -0,0 +1,79 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package almanac_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/soniakeys/meeus/v3/almanac"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/sexagesimal"
+	"github.com/soniakeys/unit"
+)
+
+// Boston, the location of example 15.a, p. 103.
+var boston = globe.Coord{
+	Lon: unit.NewAngle(' ', 71, 5, 0),
+	Lat: unit.NewAngle(' ', 42, 20, 0),
+}
+
+func ExampleTable() {
+	jd := julian.CalendarGregorianToJD(1988, 3, 20)
+	for _, d := range almanac.Table(jd, jd+2, boston) {
+		fmt.Printf("%d-%02d-%02d  Sun %02m %02m  Moon %02m %02m  k %.2f\n",
+			d.Year, d.Month, d.Day,
+			sexa.FmtTime(d.Sun.Rise), sexa.FmtTime(d.Sun.Set),
+			sexa.FmtTime(d.Moon.Rise), sexa.FmtTime(d.Moon.Set),
+			d.MoonIlluminated)
+	}
+	// Output:
+	// 1988-03-20  Sun  10ʰ47ᵐ  22ʰ57ᵐ  Moon  11ʰ51ᵐ  25ʰ15ᵐ  k 0.05
+	// 1988-03-21  Sun  10ʰ45ᵐ  22ʰ58ᵐ  Moon  12ʰ19ᵐ  26ʰ32ᵐ  k 0.11
+	// 1988-03-22  Sun  10ʰ44ᵐ  22ʰ59ᵐ  Moon  12ʰ53ᵐ  27ʰ47ᵐ  k 0.19
+}
+
+func ExampleWriteCSV() {
+	jd := julian.CalendarGregorianToJD(1988, 3, 20)
+	if err := almanac.WriteCSV(os.Stdout, almanac.Table(jd, jd+1, boston)); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// date,sunrise,suntransit,sunset,moonrise,moontransit,moonset,civildawn,civildusk,nauticaldawn,nauticaldusk,astronomicaldawn,astronomicaldusk,moonphase,moonilluminated,moonwaxing,eqtime,sidereal0
+	// 1988-03-20,10:47:12,16:51:42,22:56:56,11:50:55,19:02:45,,10:19:13,23:24:59,09:46:25,23:57:53,09:12:57,,153.6,0.052,true,-7.55,11:50:58
+	// 1988-03-21,10:45:27,16:51:24,22:58:05,12:19:23,19:55:22,,10:17:28,23:26:08,09:44:37,23:59:05,09:11:04,,140.4,0.115,true,-7.25,11:54:55
+}
+
+func ExampleDay_MarshalJSON() {
+	jd := julian.CalendarGregorianToJD(1988, 3, 20)
+	b, err := json.MarshalIndent(almanac.NewDay(jd, boston), "", " ")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(b))
+	// Output:
+	// {
+	//  "astronomicaldawn": "09:12:57",
+	//  "astronomicaldusk": null,
+	//  "civildawn": "10:19:13",
+	//  "civildusk": "23:24:59",
+	//  "date": "1988-03-20",
+	//  "eqtime": "-7.55",
+	//  "moonilluminated": "0.052",
+	//  "moonphase": "153.6",
+	//  "moonrise": "11:50:55",
+	//  "moonset": null,
+	//  "moontransit": "19:02:45",
+	//  "moonwaxing": "true",
+	//  "nauticaldawn": "09:46:25",
+	//  "nauticaldusk": "23:57:53",
+	//  "sidereal0": "11:50:58",
+	//  "sunrise": "10:47:12",
+	//  "sunset": "22:56:56",
+	//  "suntransit": "16:51:42"
+	// }
+}
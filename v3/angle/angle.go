@@ -17,7 +17,9 @@ import (
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/interp"
+	"github.com/soniakeys/meeus/v3/iterate"
 	"github.com/soniakeys/unit"
 )
 
@@ -117,6 +119,73 @@ func MinSepRect(jd1, jd3 float64, r1, d1, r2, d2 []unit.Angle) (unit.Angle, erro
 	return 0, errors.New("MinSepRect: failure to converge")
 }
 
+// SeparationSeries returns separations of a moving body from a fixed point.
+//
+// Function f gives coordinates of the body at time jd.  Separations from
+// target are computed at times jd1, jd1+step, jd1+2*step... through jd2.
+// Results are in radians.
+//
+// SepPauwels is used so that separations are accurate at any distance.
+func SeparationSeries(f func(jd float64) (unit.RA, unit.Angle), target coord.Equatorial, jd1, jd2, step float64) []float64 {
+	n := int(math.Floor((jd2-jd1)/step+1e-9)) + 1
+	if n < 1 {
+		return nil
+	}
+	s := make([]float64, n)
+	for i := range s {
+		α, δ := f(jd1 + float64(i)*step)
+		s[i] = SepPauwels(α.Angle(), δ, target.RA.Angle(), target.Dec).Rad()
+	}
+	return s
+}
+
+// ErrorNoMinimum is returned by MinSeparation when separation is least at
+// an end of the time range searched.
+var ErrorNoMinimum = errors.New("no minimum separation within time range")
+
+// MinSeparation finds the time of least separation of a moving body from
+// a fixed point, an appulse of the body to the point.
+//
+// Arguments are as for SeparationSeries.  The series is searched for the
+// least interior minimum, which is then refined to full precision between
+// the adjacent times of the series.  Step must be small enough that the
+// series resolves the approach, a few hours for the Moon, a day or more for
+// planets.
+//
+// If more than one minimum occurs in the range, only the least is found.
+// ErrorNoMinimum is returned if the series has no interior minimum.
+func MinSeparation(f func(jd float64) (unit.RA, unit.Angle), target coord.Equatorial, jd1, jd2, step float64) (jd float64, sep unit.Angle, err error) {
+	s := SeparationSeries(f, target, jd1, jd2, step)
+	m := -1
+	for i := 1; i < len(s)-1; i++ {
+		if s[i] <= s[i-1] && s[i] <= s[i+1] && (m < 0 || s[i] < s[m]) {
+			m = i
+		}
+	}
+	if m < 0 {
+		return 0, 0, ErrorNoMinimum
+	}
+	// Squared chord is smooth through a close approach where separation
+	// itself has a cusp.  Its derivative is zero at the minimum.
+	sδ0, cδ0 := target.Dec.Sincos()
+	sα0, cα0 := target.RA.Sincos()
+	chord2 := func(jd float64) float64 {
+		α, δ := f(jd)
+		sδ, cδ := δ.Sincos()
+		sα, cα := α.Sincos()
+		x := cδ*cα - cδ0*cα0
+		y := cδ*sα - cδ0*sα0
+		z := sδ - sδ0
+		return x*x + y*y + z*z
+	}
+	h := step * 1e-3
+	jd = iterate.BinaryRoot(func(jd float64) float64 {
+		return chord2(jd+h) - chord2(jd-h)
+	}, jd1+float64(m-1)*step, jd1+float64(m+1)*step)
+	α, δ := f(jd)
+	return jd, SepPauwels(α.Angle(), δ, target.RA.Angle(), target.Dec), nil
+}
+
 // SepHav returns the angular separation between two celestial bodies.
 //
 // The algorithm uses the haversine function and is superior to the naïve
@@ -149,3 +218,15 @@ func RelativePosition(r1, d1, r2, d2 unit.Angle) unit.Angle {
 	sd2, cd2 := d2.Sincos()
 	return unit.Angle(math.Atan2(sΔr, cd2*d1.Tan()-sd2*cΔr))
 }
+
+// PositionAngle returns the position angle of a direction on the sky.
+//
+// The direction is that at eq1 toward eq2.  The result is measured from
+// North through East, in the range [0, 2π).
+//
+// The position angle of the bright limb of the Moon or a planet, for
+// example, is PositionAngle of the body toward the Sun.  The formula is that
+// of base.Limb, which serves packages that cannot import coord.
+func PositionAngle(eq1, eq2 *coord.Equatorial) unit.Angle {
+	return base.Limb(eq1.RA, eq1.Dec, eq2.RA, eq2.Dec)
+}
@@ -9,6 +9,9 @@ import (
 	"testing"
 
 	"github.com/soniakeys/meeus/v3/angle"
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
+	"github.com/soniakeys/meeus/v3/interp"
 	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
@@ -113,3 +116,91 @@ func ExampleSepPauwels() {
 	// Output:
 	// 32°47′35″
 }
+
+func ExamplePositionAngle() {
+	// Position angle of the bright limb of the Moon, example 48.a, p. 347.
+	moon := &coord.Equatorial{
+		RA:  unit.RAFromDeg(134.6885),
+		Dec: unit.AngleFromDeg(13.7684),
+	}
+	sun := &coord.Equatorial{
+		RA:  unit.RAFromDeg(20.6579),
+		Dec: unit.AngleFromDeg(8.6964),
+	}
+	χ := angle.PositionAngle(moon, sun)
+	fmt.Printf("χ = %.1f\n", χ.Deg())
+	// Output:
+	// χ = 285.0
+}
+
+func TestPositionAngle(t *testing.T) {
+	for _, c := range []struct{ r1, d1, r2, d2 float64 }{
+		{134.6885, 13.7684, 20.6579, 8.6964},
+		{10, 20, 11, 19},
+		{350, -40, 5, -35},
+		{200, 80, 20, 85},
+	} {
+		eq1 := &coord.Equatorial{RA: unit.RAFromDeg(c.r1), Dec: unit.AngleFromDeg(c.d1)}
+		eq2 := &coord.Equatorial{RA: unit.RAFromDeg(c.r2), Dec: unit.AngleFromDeg(c.d2)}
+		p := angle.PositionAngle(eq1, eq2)
+		χ := base.Limb(eq1.RA, eq1.Dec, eq2.RA, eq2.Dec)
+		if math.Abs((p - χ).Rad()) > 1e-12 {
+			t.Errorf("%v: PositionAngle %.6f, Limb %.6f", c, p.Deg(), χ.Deg())
+		}
+	}
+}
+
+// mercury interpolates the positions of Mercury of the second exercise,
+// p. 110.
+func mercury(jd float64) (unit.RA, unit.Angle) {
+	ra := make([]float64, 3)
+	dec := make([]float64, 3)
+	for i := range ra {
+		ra[i] = r1[i].Rad()
+		dec[i] = d1[i].Rad()
+	}
+	r3, _ := interp.NewLen3(jd1, jd3, ra)
+	d3, _ := interp.NewLen3(jd1, jd3, dec)
+	return unit.RA(r3.InterpolateX(jd)), unit.Angle(d3.InterpolateX(jd))
+}
+
+func ExampleMinSeparation() {
+	// Appulse of Mercury to Saturn, second exercise, p. 110, with Saturn
+	// fixed at its position on the middle day.
+	saturn := coord.Equatorial{RA: unit.RA(r2[1]), Dec: d2[1]}
+	jd, sep, err := angle.MinSeparation(mercury, saturn, jd1, jd3, .25)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, m, d := julian.JDToCalendar(jd)
+	fmt.Printf("%d %d %.4f\n", y, m, d)
+	fmt.Printf("%.0d\n", sexa.FmtAngle(sep))
+	// Output:
+	// 1978 9 13.6562
+	// 3′51″
+}
+
+func TestSeparationSeries(t *testing.T) {
+	saturn := coord.Equatorial{RA: unit.RA(r2[1]), Dec: d2[1]}
+	s := angle.SeparationSeries(mercury, saturn, jd1, jd3, .5)
+	if len(s) != 5 {
+		t.Fatal(len(s))
+	}
+	// series agrees with the minimum found
+	jd, sep, err := angle.MinSeparation(mercury, saturn, jd1, jd3, .5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, x := range s {
+		if x < sep.Rad() {
+			t.Fatal(i, x, sep)
+		}
+	}
+	if jd < jd1+.5 || jd > jd3-.5 {
+		t.Fatal(jd)
+	}
+	if _, _, err = angle.MinSeparation(mercury, saturn, jd1, jd1+.5, .25); err != angle.ErrorNoMinimum {
+		t.Fatal(err)
+	}
+}
@@ -18,19 +18,112 @@ import (
 // Nutation returns corrections due to nutation for equatorial coordinates
 // of an object.
 //
-// Results are invalid for objects very near the celestial poles.
+// Results are invalid for objects very near the celestial poles.  See
+// MeanToTrue for a rigorous conversion.
 func Nutation(α unit.RA, δ unit.Angle, jd float64) (Δα1 unit.HourAngle, Δδ1 unit.Angle) {
+	var c epochTerms
+	c.nutation(jd)
+	return c.nutate(α, δ)
+}
+
+// epochTerms holds the quantities of (23.1) and (23.3) that depend only on
+// the epoch and not on the coordinates of an object.
+type epochTerms struct {
+	sε, cε, tε float64
+	Δψ, Δε     unit.Angle
+	e          float64
+	ss, cs     float64
+	sπ, cπ     float64
+}
+
+// obliquity sets the obliquity terms for jd.
+func (c *epochTerms) obliquity(jd float64) {
 	ε := nutation.MeanObliquity(jd)
-	sε, cε := ε.Sincos()
-	Δψ, Δε := nutation.Nutation(jd)
+	c.sε, c.cε = ε.Sincos()
+	c.tε = ε.Tan()
+}
+
+// nutation sets the obliquity and nutation terms for jd.
+func (c *epochTerms) nutation(jd float64) {
+	c.obliquity(jd)
+	c.Δψ, c.Δε = nutation.Nutation(jd)
+}
+
+// aberration sets the solar terms for jd.  The obliquity must already be
+// set by method obliquity or nutation.
+func (c *epochTerms) aberration(jd float64) {
+	T := base.J2000Century(jd)
+	s, _ := solar.True(T)
+	c.e = solar.Eccentricity(T)
+	c.ss, c.cs = s.Sincos()
+	c.sπ, c.cπ = perihelion(T).Sincos()
+}
+
+// nutate evaluates (23.1).
+func (c *epochTerms) nutate(α unit.RA, δ unit.Angle) (Δα1 unit.HourAngle, Δδ1 unit.Angle) {
 	sα, cα := α.Sincos()
 	tδ := δ.Tan()
 	// (23.1) p. 151
-	Δα1 = unit.HourAngle((cε+sε*sα*tδ)*Δψ.Rad() - cα*tδ*Δε.Rad())
-	Δδ1 = Δψ.Mul(sε*cα) + Δε.Mul(sα)
+	Δα1 = unit.HourAngle((c.cε+c.sε*sα*tδ)*c.Δψ.Rad() - cα*tδ*c.Δε.Rad())
+	Δδ1 = c.Δψ.Mul(c.sε*cα) + c.Δε.Mul(sα)
+	return
+}
+
+// aberrate evaluates (23.3).
+func (c *epochTerms) aberrate(α unit.RA, δ unit.Angle) (Δα2 unit.HourAngle, Δδ2 unit.Angle) {
+	sα, cα := α.Sincos()
+	sδ, cδ := δ.Sincos()
+	q1 := cα * c.cε
+	// (23.3) p. 152
+	Δα2 = unit.HourAngle(κ.Rad() * (c.e*(q1*c.cπ+sα*c.sπ) - (q1*c.cs + sα*c.ss)) / cδ)
+	q2 := c.cε * (c.tε*cδ - sα*sδ)
+	q3 := cα * sδ
+	Δδ2 = κ.Mul(c.e*(c.cπ*q2+c.sπ*q3) - (c.cs*q2 + c.ss*q3))
 	return
 }
 
+// MeanToTrue converts equatorial coordinates referred to the mean equinox
+// of date to coordinates referred to the true equinox of date.
+//
+// Only the rotation due to nutation is applied; there is no aberration or
+// precession.  Unlike the differential formula of Nutation, the rotation is
+// rigorous and valid at all declinations including near the poles.
+func MeanToTrue(α unit.RA, δ unit.Angle, jde float64) (unit.RA, unit.Angle) {
+	ε := nutation.MeanObliquity(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	return rotateNutation(α, δ, ε, Δψ, ε+Δε)
+}
+
+// TrueToMean converts equatorial coordinates referred to the true equinox
+// of date to coordinates referred to the mean equinox of date.
+//
+// It is the inverse of MeanToTrue.
+func TrueToMean(α unit.RA, δ unit.Angle, jde float64) (unit.RA, unit.Angle) {
+	ε := nutation.MeanObliquity(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	return rotateNutation(α, δ, ε+Δε, -Δψ, ε)
+}
+
+// rotateNutation rotates equatorial coordinates to the ecliptic of
+// obliquity ε1, through Δψ in longitude, and back to the equator of
+// obliquity ε2.
+func rotateNutation(α unit.RA, δ, ε1, Δψ, ε2 unit.Angle) (unit.RA, unit.Angle) {
+	sα, cα := α.Sincos()
+	sδ, cδ := δ.Sincos()
+	x, y, z := cδ*cα, cδ*sα, sδ
+	// to ecliptic
+	s1, c1 := ε1.Sincos()
+	y, z = c1*y+s1*z, c1*z-s1*y
+	// nutation in longitude
+	sψ, cψ := Δψ.Sincos()
+	x, y = cψ*x-sψ*y, sψ*x+cψ*y
+	// to equator
+	s2, c2 := ε2.Sincos()
+	y, z = c2*y-s2*z, s2*y+c2*z
+	return unit.RAFromRad(math.Atan2(y, x)),
+		unit.Angle(math.Atan2(z, math.Hypot(x, y)))
+}
+
 // κ is the constnt of aberration in radians.
 var κ = unit.AngleFromSec(20.49552)
 
@@ -58,24 +151,10 @@ func EclipticAberration(λ, β unit.Angle, jd float64) (Δλ, Δβ unit.Angle) {
 // Aberration returns corrections due to aberration for equatorial
 // coordinates of an object.
 func Aberration(α unit.RA, δ unit.Angle, jd float64) (Δα2 unit.HourAngle, Δδ2 unit.Angle) {
-	ε := nutation.MeanObliquity(jd)
-	T := base.J2000Century(jd)
-	s, _ := solar.True(T)
-	e := solar.Eccentricity(T)
-	π := perihelion(T)
-	sα, cα := α.Sincos()
-	sδ, cδ := δ.Sincos()
-	ss, cs := s.Sincos()
-	sπ, cπ := π.Sincos()
-	cε := ε.Cos()
-	tε := ε.Tan()
-	q1 := cα * cε
-	// (23.3) p. 152
-	Δα2 = unit.HourAngle(κ.Rad() * (e*(q1*cπ+sα*sπ) - (q1*cs + sα*ss)) / cδ)
-	q2 := cε * (tε*cδ - sα*sδ)
-	q3 := cα * sδ
-	Δδ2 = κ.Mul(e*(cπ*q2+sπ*q3) - (cs*q2 + ss*q3))
-	return
+	var c epochTerms
+	c.obliquity(jd)
+	c.aberration(jd)
+	return c.aberrate(α, δ)
 }
 
 // Position computes the apparent position of an object.
@@ -93,6 +172,45 @@ func Position(eqFrom, eqTo *coord.Equatorial, epochFrom, epochTo float64, mα un
 	return eqTo
 }
 
+// ProperMotion is the annual proper motion of a star.
+type ProperMotion struct {
+	RA  unit.HourAngle
+	Dec unit.Angle
+}
+
+// PositionMany computes apparent positions of many objects at a single epoch.
+//
+// Results are the same as calling Position for each element of eqs, but
+// nutation, the precession angles, and the solar terms of aberration are
+// computed only once.  Argument pm gives proper motions corresponding to
+// eqs.  It may be nil or shorter than eqs, in which case missing proper
+// motions are taken as zero.  Eqs is not modified; results are returned
+// in a new slice.
+func PositionMany(eqs []coord.Equatorial, epochFrom, epochTo float64, pm []ProperMotion) []coord.Equatorial {
+	p := precess.NewPrecessor(epochFrom, epochTo)
+	t := epochTo - epochFrom
+	var c epochTerms
+	jd := base.JulianYearToJDE(epochTo)
+	c.nutation(jd)
+	c.aberration(jd)
+	r := make([]coord.Equatorial, len(eqs))
+	for i := range eqs {
+		eq := &r[i]
+		*eq = eqs[i]
+		if i < len(pm) {
+			// proper motion as in precess.Position
+			eq.RA = unit.RAFromRad(eq.RA.Rad() + pm[i].RA.Rad()*t)
+			eq.Dec += pm[i].Dec * unit.Angle(t)
+		}
+		p.Precess(eq, eq)
+		Δα1, Δδ1 := c.nutate(eq.RA, eq.Dec)
+		Δα2, Δδ2 := c.aberrate(eq.RA, eq.Dec)
+		eq.RA = eq.RA.Add(Δα1 + Δα2)
+		eq.Dec += Δδ1 + Δδ2
+	}
+	return r
+}
+
 // AberrationRonVondrak uses the Ron-Vondrák expression to compute corrections
 // due to aberration for equatorial coordinates of an object.
 func AberrationRonVondrak(α unit.RA, δ unit.Angle, jd float64) (Δα unit.HourAngle, Δδ unit.Angle) {
@@ -311,3 +429,116 @@ func PositionRonVondrak(eqFrom, eqTo *coord.Equatorial, epochTo float64, mα uni
 	eqTo.Dec += Δδ1
 	return eqTo
 }
+
+// Deflection returns corrections due to gravitational deflection of light
+// by the Sun for equatorial coordinates of a star.
+//
+// The position of the Sun is that of package solar, and the deflection is
+// computed by the relativistic formula of the Explanatory Supplement to the
+// Astronomical Almanac, 1992, p. 148.  The correction is 1.75″ at the limb of
+// the Sun and falls off roughly inversely with elongation from the Sun.
+// Results are invalid for stars behind the disk of the Sun.
+func Deflection(α unit.RA, δ unit.Angle, jd float64) (Δα unit.HourAngle, Δδ unit.Angle) {
+	T := base.J2000Century(jd)
+	s, _ := solar.True(T)
+	R := solar.Radius(T)
+	sε, cε := nutation.MeanObliquity(jd).Sincos()
+	α0, δ0 := coord.EclToEq(s, 0, sε, cε)
+	// p is the direction of the star, e the direction from Sun to Earth.
+	sα, cα := α.Sincos()
+	sδ, cδ := δ.Sincos()
+	p := [3]float64{cδ * cα, cδ * sα, sδ}
+	sα0, cα0 := α0.Sincos()
+	sδ0, cδ0 := δ0.Sincos()
+	e := [3]float64{-cδ0 * cα0, -cδ0 * sα0, -sδ0}
+	pe := p[0]*e[0] + p[1]*e[1] + p[2]*e[2]
+	// 2μ/c² in AU, divided by the distance of the Earth from the Sun
+	g := 1.97412574336e-8 / R / (1 + pe)
+	for i := range p {
+		p[i] += g * (e[i] - pe*p[i])
+	}
+	Δα = unit.HourAngle(math.Atan2(p[1], p[0]) - α.Rad())
+	if Δα > math.Pi {
+		Δα -= 2 * math.Pi
+	} else if Δα < -math.Pi {
+		Δα += 2 * math.Pi
+	}
+	Δδ = unit.Angle(math.Atan2(p[2], math.Hypot(p[0], p[1]))) - δ
+	return
+}
+
+// Aberration models selectable in Options.
+const (
+	ModelMeeus      = iota // function Aberration, formula 23.3
+	ModelRonVondrak        // function AberrationRonVondrak
+)
+
+// Options selects the corrections applied by Reduce.
+type Options struct {
+	Precession      bool // apply precession from epochFrom to the epoch of jde
+	Nutation        bool // apply nutation
+	Aberration      bool // apply annual aberration
+	AberrationModel int  // ModelMeeus or ModelRonVondrak
+	Deflection      bool // apply deflection of light by the Sun
+}
+
+// DefaultOptions are the corrections applied by Position.
+var DefaultOptions = Options{
+	Precession:      true,
+	Nutation:        true,
+	Aberration:      true,
+	AberrationModel: ModelMeeus,
+}
+
+// Reduce computes the position of an object with selected corrections.
+//
+// Position is computed for equatorial coordinates in eqFrom at epochFrom,
+// for the time jde.  Proper motion mα, mδ is always applied.  Other
+// corrections are applied as selected by opt.  Result is in eqTo.  EqFrom and
+// eqTo must be non-nil, but may point to the same struct.
+//
+// With DefaultOptions the result is that of Position.  With
+// ModelRonVondrak, corrections are applied in the order of
+// PositionRonVondrak:  aberration and deflection to the coordinates at
+// epochFrom, which must then be J2000, followed by precession and nutation.
+// Otherwise precession is applied first, then deflection, nutation and
+// aberration.
+func Reduce(eqFrom, eqTo *coord.Equatorial, epochFrom, jde float64, mα unit.HourAngle, mδ unit.Angle, opt *Options) *coord.Equatorial {
+	epochTo := base.JDEToJulianYear(jde)
+	rv := opt.Aberration && opt.AberrationModel == ModelRonVondrak
+	if opt.Precession && !rv {
+		precess.Position(eqFrom, eqTo, epochFrom, epochTo, mα, mδ)
+	} else {
+		t := epochTo - epochFrom
+		eqTo.RA = eqFrom.RA.Add(mα.Mul(t))
+		eqTo.Dec = eqFrom.Dec + mδ.Mul(t)
+	}
+	var Δα1, Δα2, Δα3 unit.HourAngle
+	var Δδ1, Δδ2, Δδ3 unit.Angle
+	if opt.Deflection {
+		Δα3, Δδ3 = Deflection(eqTo.RA, eqTo.Dec, jde)
+	}
+	if rv {
+		Δα2, Δδ2 = AberrationRonVondrak(eqTo.RA, eqTo.Dec, jde)
+		eqTo.RA = eqTo.RA.Add(Δα2 + Δα3)
+		eqTo.Dec += Δδ2 + Δδ3
+		if opt.Precession {
+			precess.Position(eqTo, eqTo, epochFrom, epochTo, 0, 0)
+		}
+		if opt.Nutation {
+			Δα1, Δδ1 = Nutation(eqTo.RA, eqTo.Dec, jde)
+			eqTo.RA = eqTo.RA.Add(Δα1)
+			eqTo.Dec += Δδ1
+		}
+		return eqTo
+	}
+	if opt.Nutation {
+		Δα1, Δδ1 = Nutation(eqTo.RA, eqTo.Dec, jde)
+	}
+	if opt.Aberration {
+		Δα2, Δδ2 = Aberration(eqTo.RA, eqTo.Dec, jde)
+	}
+	eqTo.RA = eqTo.RA.Add(Δα1 + Δα2 + Δα3)
+	eqTo.Dec += Δδ1 + Δδ2 + Δδ3
+	return eqTo
+}
@@ -5,11 +5,14 @@ package apparent_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
 
 	"github.com/soniakeys/meeus/v3/apparent"
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/solar"
 	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
 )
@@ -27,6 +30,38 @@ func ExampleNutation() {
 	// 15.843″  6.217″
 }
 
+func ExampleMeanToTrue() {
+	// Example 23.a, p. 152, with the rigorous rotation.
+	α := unit.NewRA(2, 46, 11.331)
+	δ := unit.NewAngle(' ', 49, 20, 54.54)
+	jd := julian.CalendarGregorianToJD(2028, 11, 13.19)
+	αt, δt := apparent.MeanToTrue(α, δ, jd)
+	fmt.Printf("%.3s  %.3s\n",
+		sexa.FmtAngle(unit.Angle(αt-α)),
+		sexa.FmtAngle(δt-δ))
+	// Output:
+	// 15.843″  6.217″
+}
+
+func TestTrueToMean(t *testing.T) {
+	jd := julian.CalendarGregorianToJD(2028, 11, 13.19)
+	for _, δ := range []unit.Angle{
+		unit.AngleFromDeg(-30),
+		unit.AngleFromDeg(89.99),
+		unit.AngleFromDeg(-89.9999),
+	} {
+		for h := 0.; h < 24; h += 5 {
+			α := unit.RAFromHour(h)
+			αt, δt := apparent.MeanToTrue(α, δ, jd)
+			αm, δm := apparent.TrueToMean(αt, δt, jd)
+			if math.Abs((δm-δ).Rad()) > 1e-14 ||
+				math.Abs(math.Remainder((αm-α).Rad(), 2*math.Pi)*δ.Cos()) > 1e-14 {
+				t.Fatal(h, δ.Deg(), αm-α, δm-δ)
+			}
+		}
+	}
+}
+
 func ExampleAberration() {
 	// Example 23.a, p. 152
 	α := unit.NewRA(2, 46, 11.331)
@@ -57,6 +92,52 @@ func ExamplePosition() {
 	// δ = 49°21′07″.45
 }
 
+func ExamplePositionMany() {
+	// Example 23.a, p. 152, with a second star without proper motion.
+	jd := julian.CalendarGregorianToJD(2028, 11, 13.19)
+	eqs := []coord.Equatorial{
+		{RA: unit.NewRA(2, 44, 11.986), Dec: unit.NewAngle(' ', 49, 13, 42.48)},
+		{RA: unit.NewRA(14, 15, 39.7), Dec: unit.NewAngle(' ', 19, 10, 57)},
+	}
+	pm := []apparent.ProperMotion{
+		{RA: unit.HourAngleFromSec(.03425), Dec: unit.AngleFromSec(-.0895)},
+	}
+	for _, eq := range apparent.PositionMany(eqs, 2000,
+		base.JDEToJulianYear(jd), pm) {
+		fmt.Printf("α = %0.3d  δ = %+0.2d\n",
+			sexa.FmtRA(eq.RA), sexa.FmtAngle(eq.Dec))
+	}
+	// Output:
+	// α = 2ʰ46ᵐ14ˢ.390  δ = +49°21′07″.45
+	// α = 14ʰ17ᵐ00ˢ.536  δ = +19°02′53″.96
+}
+
+func TestPositionMany(t *testing.T) {
+	epoch := 2033.4
+	eqs := []coord.Equatorial{
+		{RA: unit.NewRA(2, 44, 11.986), Dec: unit.NewAngle(' ', 49, 13, 42.48)},
+		{RA: unit.NewRA(14, 15, 39.7), Dec: unit.NewAngle(' ', 19, 10, 57)},
+		{RA: unit.NewRA(23, 59, 1), Dec: unit.NewAngle('-', 62, 3, 4)},
+	}
+	pm := []apparent.ProperMotion{
+		{RA: unit.HourAngleFromSec(.03425), Dec: unit.AngleFromSec(-.0895)},
+		{RA: unit.HourAngleFromSec(-.07714), Dec: unit.AngleFromSec(-1.9984)},
+	}
+	got := apparent.PositionMany(eqs, 2000, epoch, pm)
+	for i := range eqs {
+		var mα unit.HourAngle
+		var mδ unit.Angle
+		if i < len(pm) {
+			mα, mδ = pm[i].RA, pm[i].Dec
+		}
+		var want coord.Equatorial
+		apparent.Position(&eqs[i], &want, 2000, epoch, mα, mδ)
+		if got[i] != want {
+			t.Errorf("star %d: got %v, want %v", i, got[i], want)
+		}
+	}
+}
+
 func ExampleAberrationRonVondrak() {
 	// Example 23.b, p. 156
 	α := unit.NewRA(2, 44, 12.9747)
@@ -86,3 +167,53 @@ func ExamplePositionRonVondrak() {
 	// α = 2ʰ46ᵐ14ˢ.392
 	// δ = 49°21′07″.45
 }
+
+func ExampleReduce() {
+	// Example 23.a, p. 152, with and without some corrections.
+	jde := julian.CalendarGregorianToJD(2028, 11, 13.19)
+	eqFrom := &coord.Equatorial{
+		RA:  unit.NewRA(2, 44, 11.986),
+		Dec: unit.NewAngle(' ', 49, 13, 42.48),
+	}
+	mα := unit.HourAngleFromSec(.03425)
+	mδ := unit.AngleFromSec(-.0895)
+	noNut := apparent.DefaultOptions
+	noNut.Nutation = false
+	rv := apparent.DefaultOptions
+	rv.AberrationModel = apparent.ModelRonVondrak
+	all := rv
+	all.Deflection = true
+	for _, o := range []struct {
+		name string
+		opt  *apparent.Options
+	}{
+		{"default     ", &apparent.DefaultOptions},
+		{"no nutation ", &noNut},
+		{"Ron-Vondrák ", &rv},
+		{"+ deflection", &all},
+	} {
+		var eq coord.Equatorial
+		apparent.Reduce(eqFrom, &eq, 2000, jde, mα, mδ, o.opt)
+		fmt.Printf("%s  α = %0.3d  δ = %0.2d\n", o.name,
+			sexa.FmtRA(eq.RA), sexa.FmtAngle(eq.Dec))
+	}
+	// Output:
+	// default       α = 2ʰ46ᵐ14ˢ.390  δ = 49°21′07″.45
+	// no nutation   α = 2ʰ46ᵐ13ˢ.334  δ = 49°21′01″.23
+	// Ron-Vondrák   α = 2ʰ46ᵐ14ˢ.392  δ = 49°21′07″.45
+	// + deflection  α = 2ʰ46ᵐ14ˢ.392  δ = 49°21′07″.45
+}
+
+func TestDeflection(t *testing.T) {
+	// a star one solar radius north of the center of the Sun
+	jd := julian.CalendarGregorianToJD(2028, 11, 13.19)
+	α0, δ0 := solar.TrueEquatorial(jd)
+	δ := δ0 + unit.AngleFromDeg(.2666)
+	Δα, Δδ := apparent.Deflection(α0, δ, jd)
+	if math.Abs(Δα.Rad()) > 1e-8 {
+		t.Fatal("Δα", Δα.Rad())
+	}
+	if d := Δδ.Sec(); d < 1.7 || d > 1.8 {
+		t.Fatal("Δδ", d)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/globe"
 	"github.com/soniakeys/unit"
 )
 
@@ -75,6 +76,55 @@ type la struct {
 	D, M, F float64
 }
 
+// ApogeeDistance returns the distance between the centers of the Earth and
+// Moon at the Apogee nearest the given date.
+//
+// Year is a decimal year specifying a date.  Result is in km.
+func ApogeeDistance(year float64) float64 {
+	return Distance(ApogeeParallax(year))
+}
+
+// PerigeeDistance returns the distance between the centers of the Earth and
+// Moon at the Perigee nearest the given date.
+//
+// Year is a decimal year specifying a date.  Result is in km.
+func PerigeeDistance(year float64) float64 {
+	return Distance(PerigeeParallax(year))
+}
+
+// ApogeeSemidiameter returns the geocentric semidiameter of the Moon at the
+// Apogee nearest the given date.
+//
+// Year is a decimal year specifying a date.
+func ApogeeSemidiameter(year float64) unit.Angle {
+	return Semidiameter(ApogeeParallax(year))
+}
+
+// PerigeeSemidiameter returns the geocentric semidiameter of the Moon at the
+// Perigee nearest the given date.
+//
+// Year is a decimal year specifying a date.
+func PerigeeSemidiameter(year float64) unit.Angle {
+	return Semidiameter(PerigeeParallax(year))
+}
+
+// Distance returns the distance in km between the centers of the Earth and
+// Moon given the equatorial horizontal parallax π of the Moon.
+//
+// It is the inverse of moonposition.Parallax.
+func Distance(π unit.Angle) float64 {
+	return globe.Earth76.Er / π.Sin()
+}
+
+// Semidiameter returns the geocentric semidiameter of the Moon given its
+// equatorial horizontal parallax π.
+//
+// The ratio of the radii of the Moon and Earth is that used by
+// semidiameter.MoonTopocentric.
+func Semidiameter(π unit.Angle) unit.Angle {
+	return unit.Angle(math.Asin(.272481 * π.Sin()))
+}
+
 const p = math.Pi / 180
 
 func newLa(y, h float64) *la {
@@ -46,6 +46,15 @@ func ExampleApogeeParallax() {
 	// 54′00″.679
 }
 
+func ExampleApogeeDistance() {
+	// Example 50.a, p. 357.
+	fmt.Printf("%.0f km\n", apsis.ApogeeDistance(1988.75))
+	fmt.Printf("%.2f′\n", apsis.ApogeeSemidiameter(1988.75).Min())
+	// Output:
+	// 405977 km
+	// 14.72′
+}
+
 // Test cases from p. 361.
 func TestPerigee(t *testing.T) {
 	for _, c := range []struct {
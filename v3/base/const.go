@@ -13,6 +13,14 @@ const AU = 149597870
 
 // from Appendix I, p, 407.
 
+// C is the speed of light in km/s.
+const C = 299792.458
+
+// EarthRadius is the equatorial radius of the Earth in km.
+const EarthRadius = 6378.14
+
+// C, EarthRadius from Appendix I, p. 407.
+
 // SOblJ2000, COblJ2000 are sine and cosine of obliquity at J2000.
 const (
 	SOblJ2000 = .397777156
@@ -21,12 +29,55 @@ const (
 
 // SOblJ2000, COblJ2000 from ch 33, p. 228, for example
 
-// LightTime returns time for light to travel a given distance.
+// ConstantSet is a consistent set of the physical constants used by the
+// library.
+//
+// Functions of the library use the values of IAU1976, as the book does.  To
+// use another set, pass it explicitly:  the LightTime method computes light
+// time with the AU and C of the set, and globe.EarthEllipsoid returns an
+// ellipsoid with the EarthRadius of the set.  That ellipsoid, as the Datum of
+// a globe.Observer, is used for parallax by parallax.TopocentricObserverKm
+// and sky.Snapshot.  No other function honours a set other than IAU1976.
+type ConstantSet struct {
+	AU          float64 // astronomical unit, km
+	C           float64 // speed of light, km/s
+	EarthRadius float64 // equatorial radius of the Earth, km
+}
+
+// IAU1976 is the set of constants from the IAU 1976 system, as used in the
+// book.  Values are those of the constants AU, C, and EarthRadius.
+var IAU1976 = ConstantSet{
+	AU:          AU,
+	C:           C,
+	EarthRadius: EarthRadius,
+}
+
+// IAU2015 is a set of constants with modern values:  the AU as defined
+// exactly by IAU 2012 Resolution B2 and the nominal equatorial radius of
+// the Earth of IAU 2015 Resolution B3.
+var IAU2015 = ConstantSet{
+	AU:          149597870.7,
+	C:           C,
+	EarthRadius: 6378.1,
+}
+
+// LightTime returns time for light to travel a given distance, using the
+// constants of IAU1976.
 //
 // Δ is distance in AU.
 //
 // Result in days.
 func LightTime(Δ float64) float64 {
-	// Formula given as (33.3) p. 224.
-	return .0057755183 * Δ
+	return IAU1976.LightTime(Δ)
+}
+
+// LightTime returns time for light to travel a given distance using the
+// constants of the set.
+//
+// Δ is distance in AU.
+//
+// Result in days.
+func (s *ConstantSet) LightTime(Δ float64) float64 {
+	// Formula given as (33.3) p. 224, where .0057755183 = AU / C / 86400.
+	return Δ * s.AU / s.C / 86400
 }
@@ -0,0 +1,28 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package base_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/soniakeys/meeus/v3/base"
+)
+
+func ExampleConstantSet_LightTime() {
+	// Light time for 1 AU, in seconds.
+	fmt.Printf("%.6f\n", base.IAU1976.LightTime(1)*86400)
+	fmt.Printf("%.6f\n", base.IAU2015.LightTime(1)*86400)
+	// Output:
+	// 499.004782
+	// 499.004784
+}
+
+func TestLightTime(t *testing.T) {
+	// coefficient of formula 33.3, p. 224.
+	if got := base.LightTime(1); math.Abs(got-.0057755183) > 1e-10 {
+		t.Fatal("LightTime(1) =", got)
+	}
+}
@@ -9,7 +9,8 @@
 // Bessellian and Julian Year
 //
 // Chapter 21, Precession actually contains these definitions.  They are moved
-// here because of their general utility.
+// here because of their general utility.  Functions are provided to convert
+// in both directions among Julian days, Julian years, and Besselian years.
 //
 // Chapter 22, Nutation contains the function for Julian centuries since J2000.
 //
@@ -23,14 +24,19 @@
 //
 // SmallAngle is recommended in chapter 17, p. 109.
 //
-// PMod addresses the issue on p. 7, chapter 1, in the section "Trigonometric
-// functions of large angles", but the function is not written to be specific
-// to angles and so has more general utility.
+// PMod, now unit.PMod, addresses the issue on p. 7, chapter 1, in the section
+// "Trigonometric functions of large angles", but the function is not written
+// to be specific to angles and so has more general utility.
+// ReduceAngleAccurate addresses the same issue for arguments of the form
+// a0 + rate*t with t far from the epoch, where it avoids the precision loss
+// of forming and reducing a large angle in float64 arithmetic.
 //
 // Horner is described on p. 10, chapter 1.
 //
-// FloorDiv and FloorDiv64 are optimizations for the INT function described
-// on p. 60, chapter 7.
+// FloorDiv, FloorDiv32, and FloorDiv64 are optimizations for the INT function
+// described on p. 60, chapter 7.  PModInt, PModInt32, PModInt64, and PMod32
+// are integer and float32 counterparts of unit.PMod.  Go generics are not
+// used so that the library continues to build with older Go versions.
 //
 // Unit types and conversions
 //
@@ -42,4 +48,13 @@
 //
 // Also the function FromSexa takes sexagesimal components such as degrees
 // minutes and seconds and returns a single value.
+//
+// Encoding
+//
+// Several library types such as coord.Equatorial and globe.Coord have
+// MarshalJSON, JSONValue, and CSVRecord methods.  An AngleUnit argument to
+// JSONValue and CSVRecord selects whether angles in these encodings are
+// radians, degrees, or sexagesimal strings; MarshalJSON uses degrees.
+// AngleUnit methods JSONAngle, JSONRA, CSVAngle, and CSVRA do the
+// formatting.
 package base
@@ -0,0 +1,96 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package base
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/soniakeys/unit"
+)
+
+// AngleUnit selects the representation of angles in the JSON and CSV
+// encodings of library types.
+//
+// A unit is passed to each encoding call, as to the JSONValue and CSVRecord
+// methods of library types, so encoders with different units can run
+// concurrently.  MarshalJSON methods of library types use Degree.
+type AngleUnit int
+
+// Angle units for encoding.
+//
+// With Radian and Degree, angles and right ascensions are encoded as numbers.
+// With Sexagesimal, angles are encoded as strings such as "-12:34:56.78" and
+// right ascensions as strings of hours such as "12:34:56.789".
+const (
+	Radian AngleUnit = iota
+	Degree
+	Sexagesimal
+)
+
+// JSONAngle returns a value for encoding angle a with package encoding/json,
+// in unit u.
+func (u AngleUnit) JSONAngle(a unit.Angle) interface{} {
+	switch u {
+	case Radian:
+		return a.Rad()
+	case Sexagesimal:
+		return fmtSexa(a.Deg(), 2, true)
+	}
+	return a.Deg()
+}
+
+// JSONRA returns a value for encoding right ascension r with package
+// encoding/json, in unit u.
+func (u AngleUnit) JSONRA(r unit.RA) interface{} {
+	switch u {
+	case Radian:
+		return r.Rad()
+	case Sexagesimal:
+		s := fmtSexa(r.Hour(), 3, false)
+		if strings.HasPrefix(s, "24:") {
+			s = "00" + s[2:] // rounded up to 24h
+		}
+		return s
+	}
+	return r.Deg()
+}
+
+// CSVAngle formats angle a as a CSV field in unit u.
+func (u AngleUnit) CSVAngle(a unit.Angle) string {
+	return csvField(u.JSONAngle(a))
+}
+
+// CSVRA formats right ascension r as a CSV field in unit u.
+func (u AngleUnit) CSVRA(r unit.RA) string {
+	return csvField(u.JSONRA(r))
+}
+
+func csvField(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return v.(string)
+}
+
+// fmtSexa formats x as colon separated sexagesimal components with prec
+// decimal places in the last component.
+func fmtSexa(x float64, prec int, signed bool) string {
+	sign := ""
+	switch {
+	case x < 0:
+		sign = "-"
+		x = -x
+	case signed:
+		sign = "+"
+	}
+	p := int64(math.Pow(10, float64(prec)))
+	// round once so that carries propagate to minutes and hours
+	n := int64(math.Floor(x*3600*float64(p) + .5))
+	s := n / p
+	return fmt.Sprintf("%s%02d:%02d:%02d.%0*d",
+		sign, s/3600, s/60%60, s%60, prec, n%p)
+}
@@ -0,0 +1,34 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package base_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/unit"
+)
+
+func ExampleAngleUnit_CSVAngle() {
+	a := unit.NewAngle('-', 16, 42, 58)
+	r := unit.NewRA(6, 45, 8.9)
+	for _, u := range []base.AngleUnit{base.Radian, base.Degree, base.Sexagesimal} {
+		fmt.Printf("%.20s  %.20s\n", u.CSVRA(r), u.CSVAngle(a))
+	}
+	// Output:
+	// 1.76779309390854  -0.29175117701809655
+	// 101.28708333333334  -16.71611111111111
+	// 06:45:08.900  -16:42:58.00
+}
+
+func TestCSVAngleCarry(t *testing.T) {
+	u := base.Sexagesimal
+	if s := u.CSVAngle(unit.AngleFromDeg(29.999999999)); s != "+30:00:00.00" {
+		t.Fatal(s)
+	}
+	if s := u.CSVRA(unit.RAFromHour(23.9999999999)); s != "00:00:00.000" {
+		t.Fatal(s)
+	}
+}
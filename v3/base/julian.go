@@ -3,6 +3,8 @@
 
 package base
 
+import "github.com/soniakeys/unit"
+
 // Julian and Besselian years described in chapter 21, Precession.
 // T, Julian centuries since J2000 described in chapter 22, Nutation.
 
@@ -15,11 +17,23 @@ const J2000 = 2451545.0
 // Julian days of common epochs.
 const (
 	J1900 = 2415020.0
+	J1950 = 2433282.5
+	J2100 = 2488070.0
+	B1875 = 2405889.25855
 	B1900 = 2415020.3135
 	B1950 = 2433282.4235
 )
 
-// B1900, B1950 from p. 133
+// B1900, B1950 from p. 133.  Note that B1950 is not exactly
+// BesselianYearToJDE(1950), as both constants are rounded to four decimal
+// places.
+//
+// The book uses B1950 rounded to three decimal places, 2433282.423, in
+// chapters 44 and 46.
+//
+// B1875 is the epoch of the IAU constellation boundaries, as given by
+// Roman, "Identification of a Constellation from a Position", PASP 99,
+// 1987.
 
 // JulianYear and other common periods.
 const (
@@ -58,3 +72,51 @@ func J2000Century(jde float64) float64 {
 	// (25.1) p. 163.
 	return (jde - J2000) / JulianCentury
 }
+
+// JulianYearToBesselianYear returns the Besselian year corresponding to
+// a Julian year.
+func JulianYearToBesselianYear(jy float64) float64 {
+	return JDEToBesselianYear(JulianYearToJDE(jy))
+}
+
+// BesselianYearToJulianYear returns the Julian year corresponding to
+// a Besselian year.
+func BesselianYearToJulianYear(by float64) float64 {
+	return JDEToJulianYear(BesselianYearToJDE(by))
+}
+
+// JDToMJD returns the modified Julian date for a Julian date.
+func JDToMJD(jd float64) float64 {
+	return jd - JMod
+}
+
+// MJDToJD returns the Julian date for a modified Julian date.
+func MJDToJD(mjd float64) float64 {
+	return mjd + JMod
+}
+
+// JDE is a Julian ephemeris day, a Julian day in dynamical time.
+//
+// JDE and JDUT are distinct types so that the compiler can catch the use
+// of a day in one time scale where the other is required.  Conversions
+// between them require ΔT; see deltat.JDE and deltat.JDUT.
+type JDE float64
+
+// JDUT is a Julian day in universal time.
+type JDUT float64
+
+// Century returns the number of Julian centuries since J2000.
+func (j JDE) Century() float64 {
+	return J2000Century(float64(j))
+}
+
+// TT returns the Julian ephemeris day corresponding to j for the given ΔT.
+func (j JDUT) TT(ΔT unit.Time) JDE {
+	return JDE(float64(j) + ΔT.Day())
+}
+
+// UT returns the Julian day in universal time corresponding to j for the
+// given ΔT.
+func (j JDE) UT(ΔT unit.Time) JDUT {
+	return JDUT(float64(j) - ΔT.Day())
+}
@@ -0,0 +1,73 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package base_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/soniakeys/meeus/v3/base"
+)
+
+func ExampleBesselianYearToJDE() {
+	// Common epochs.  See p. 133.
+	fmt.Printf("B1875.0 = JDE %.4f\n", base.BesselianYearToJDE(1875))
+	fmt.Printf("B1900.0 = JDE %.4f\n", base.BesselianYearToJDE(1900))
+	fmt.Printf("B1950.0 = JDE %.4f\n", base.BesselianYearToJDE(1950))
+	// Output:
+	// B1875.0 = JDE 2405889.2585
+	// B1900.0 = JDE 2415020.3135
+	// B1950.0 = JDE 2433282.4234
+}
+
+func ExampleJulianYearToBesselianYear() {
+	fmt.Printf("J2000.0 = B%.6f\n", base.JulianYearToBesselianYear(2000))
+	fmt.Printf("B1950.0 = J%.6f\n", base.BesselianYearToJulianYear(1950))
+	// Output:
+	// J2000.0 = B2000.001278
+	// B1950.0 = J1949.999790
+}
+
+func TestEpochs(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		jde    float64
+		julian bool
+		year   float64
+	}{
+		{"J1900", base.J1900, true, 1900},
+		{"J2000", base.J2000, true, 2000},
+		{"J2100", base.J2100, true, 2100},
+		{"B1875", base.B1875, false, 1875},
+		{"B1900", base.B1900, false, 1900},
+		{"B1950", base.B1950, false, 1950},
+	} {
+		if tc.julian {
+			if y := base.JDEToJulianYear(tc.jde); math.Abs(y-tc.year) > 1e-9 {
+				t.Errorf("%s: Julian year %.9f", tc.name, y)
+			}
+			if j := base.JulianYearToJDE(tc.year); math.Abs(j-tc.jde) > 1e-9 {
+				t.Errorf("%s: JDE %.9f", tc.name, j)
+			}
+			continue
+		}
+		// Besselian epoch constants are rounded to 1e-4 day.
+		if y := base.JDEToBesselianYear(tc.jde); math.Abs(y-tc.year) > 1e-6 {
+			t.Errorf("%s: Besselian year %.9f", tc.name, y)
+		}
+		if j := base.BesselianYearToJDE(tc.year); math.Abs(j-tc.jde) > 1e-4 {
+			t.Errorf("%s: JDE %.9f", tc.name, j)
+		}
+	}
+}
+
+func TestMJD(t *testing.T) {
+	if m := base.JDToMJD(base.J2000); m != 51544.5 {
+		t.Error("MJD of J2000:", m)
+	}
+	if j := base.MJDToJD(0); j != base.JMod {
+		t.Error("JD of MJD 0:", j)
+	}
+}
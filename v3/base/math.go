@@ -3,7 +3,11 @@
 
 package base
 
-import "github.com/soniakeys/unit"
+import (
+	"math"
+
+	"github.com/soniakeys/unit"
+)
 
 // SmallAngle is threshold used by various routines for switching between
 // trigonometric functions and Pythagorean approximations.
@@ -60,6 +64,95 @@ func FloorDiv64(x, y int64) (q int64) {
 	return
 }
 
+// FloorDiv32 returns the integer floor of the fractional value (x / y).
+//
+// It is FloorDiv for int32 arguments.
+func FloorDiv32(x, y int32) (q int32) {
+	q = x / y
+	if (x < 0) != (y < 0) && x%y != 0 {
+		q--
+	}
+	return
+}
+
+// PModInt returns a positive x mod y for a positive y.
+//
+// It is the integer counterpart of unit.PMod.  Argument x can be positive or
+// negative, but y should be positive.  With this restriction on y, PModInt
+// returns a value in the range [0,y).  As with built in integer division, it
+// panics with y == 0.
+func PModInt(x, y int) int {
+	r := x % y
+	if r < 0 {
+		r += y
+	}
+	return r
+}
+
+// PModInt32 is PModInt for int32 arguments.
+func PModInt32(x, y int32) int32 {
+	r := x % y
+	if r < 0 {
+		r += y
+	}
+	return r
+}
+
+// PModInt64 is PModInt for int64 arguments.
+func PModInt64(x, y int64) int64 {
+	r := x % y
+	if r < 0 {
+		r += y
+	}
+	return r
+}
+
+// PMod32 returns a positive x mod y for a positive y.
+//
+// It is unit.PMod for float32 arguments.  The remainder is computed in
+// float64 and so is exact before conversion.
+func PMod32(x, y float32) float32 {
+	r := math.Mod(float64(x), float64(y))
+	if r < 0 {
+		r += float64(y)
+	}
+	return float32(r)
+}
+
+// ReduceAngleAccurate returns the angle a0 + rate*t reduced to the range
+// [0, 2π).
+//
+// The expression is the usual form of a mean longitude or other fundamental
+// argument, with t in Julian centuries or days.  For t very far from the
+// epoch, rate*t is many thousands of revolutions and both the product and
+// a plain reduction by unit.PMod lose the low order bits of the result.
+// ReduceAngleAccurate evaluates the product and the reduction with
+// double-float arithmetic, carrying the rounding error of each step and
+// the part of 2π not representable in a float64, so that the result is good
+// to about an ulp, treating a0, rate, and t as exact.
+func ReduceAngleAccurate(a0, rate unit.Angle, t float64) unit.Angle {
+	// 2π as the unevaluated sum of two float64s
+	const twoPiHi = 2 * math.Pi
+	const twoPiLo = 2.4492935982947064e-16
+	// p + pe = rate*t exactly
+	p := rate.Rad() * t
+	pe := math.FMA(rate.Rad(), t, -p)
+	// s + se = a0 + p + pe, accumulating the rounding error of the sum
+	s := a0.Rad() + p
+	bb := s - a0.Rad()
+	se := (a0.Rad() - (s - bb)) + (p - bb) + pe
+	// reduce.  FMA gives s - k*twoPiHi with a single rounding.
+	k := math.Floor(s / twoPiHi)
+	r := math.FMA(-k, twoPiHi, s) + (se - k*twoPiLo)
+	switch {
+	case r < 0:
+		r += twoPiHi
+	case r >= twoPiHi:
+		r -= twoPiHi
+	}
+	return unit.Angle(r)
+}
+
 // Cmp compares two float64s and returns -1, 0, or 1 if a is <, ==, or > b,
 // respectively.
 //
@@ -74,3 +167,30 @@ func Cmp(a, b float64) int {
 	}
 	return 0
 }
+
+// Multiples holds cos kx + i sin kx for integer multiples k = -4..4 of
+// an angle x, indexed by k + 4.
+//
+// Periodic series such as those for nutation and the position of the Moon
+// have arguments that are small integer combinations of a few fundamental
+// angles.  The sine and cosine of such an argument are the imaginary and real
+// parts of the product of the corresponding Multiples, which is cheaper to
+// compute than a call to math.Sincos for each term.
+type Multiples [9]complex128
+
+// NewMultiples computes Multiples of x.
+func NewMultiples(x float64) (m Multiples) {
+	s, c := math.Sincos(x)
+	e := complex(c, s)
+	m[4] = 1
+	for k := 5; k < 9; k++ {
+		m[k] = m[k-1] * e
+		m[8-k] = complex(real(m[k]), -imag(m[k]))
+	}
+	return
+}
+
+// Exp returns cos kx + i sin kx for -4 <= k <= 4.
+func (m *Multiples) Exp(k int) complex128 {
+	return m[k+4]
+}
@@ -5,9 +5,12 @@ package base_test
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/unit"
 )
 
 func ExampleFloorDiv() {
@@ -68,3 +71,99 @@ func TestHorner(t *testing.T) {
 		t.Fatal("Horner")
 	}
 }
+
+func TestMultiples(t *testing.T) {
+	x := 1.2345
+	m := base.NewMultiples(x)
+	for k := -4; k <= 4; k++ {
+		s, c := math.Sincos(float64(k) * x)
+		z := m.Exp(k)
+		if math.Abs(real(z)-c) > 1e-15 || math.Abs(imag(z)-s) > 1e-15 {
+			t.Fatal(k, z, c, s)
+		}
+	}
+}
+
+func ExamplePModInt() {
+	fmt.Println(base.PModInt(+5, 3), base.PModInt(-5, 3), base.PModInt(-6, 3))
+	fmt.Println(base.PModInt32(-5, 3), base.PModInt64(-5, 3))
+	fmt.Println(base.PMod32(-1.5, 1))
+	// Output:
+	// 2 1 0
+	// 1 1
+	// 0.5
+}
+
+func TestTypedVariants(t *testing.T) {
+	for x := -20; x <= 20; x++ {
+		for _, y := range []int{-7, -3, -1, 1, 3, 7} {
+			q := base.FloorDiv(x, y)
+			if q32 := base.FloorDiv32(int32(x), int32(y)); int(q32) != q {
+				t.Fatal("FloorDiv32", x, y, q32, q)
+			}
+			if q64 := base.FloorDiv64(int64(x), int64(y)); int(q64) != q {
+				t.Fatal("FloorDiv64", x, y, q64, q)
+			}
+			if y < 0 {
+				continue
+			}
+			m := base.PModInt(x, y)
+			if m < 0 || m >= y || (x-m)%y != 0 {
+				t.Fatal("PModInt", x, y, m)
+			}
+			if m32 := base.PModInt32(int32(x), int32(y)); int(m32) != m {
+				t.Fatal("PModInt32", x, y, m32, m)
+			}
+			if m64 := base.PModInt64(int64(x), int64(y)); int(m64) != m {
+				t.Fatal("PModInt64", x, y, m64, m)
+			}
+			if f := base.PMod32(float32(x), float32(y)); int(f) != m {
+				t.Fatal("PMod32", x, y, f, m)
+			}
+		}
+	}
+}
+
+// reduceBig computes a0 + rate*t mod 2π with 256 bit arithmetic.
+func reduceBig(a0, rate unit.Angle, t float64) float64 {
+	const prec = 256
+	twoPi, _, _ := big.ParseFloat("6.28318530717958647692528676655900576839"+
+		"433879875021164194988918461563281257241799725606965068", 10, prec,
+		big.ToNearestEven)
+	x := new(big.Float).SetPrec(prec).SetFloat64(rate.Rad())
+	x.Mul(x, new(big.Float).SetFloat64(t))
+	x.Add(x, new(big.Float).SetFloat64(a0.Rad()))
+	q := new(big.Float).SetPrec(prec).Quo(x, twoPi)
+	k, _ := q.Int(nil)
+	if q.Sign() < 0 && !q.IsInt() {
+		k.Sub(k, big.NewInt(1))
+	}
+	x.Sub(x, new(big.Float).SetPrec(prec).Mul(twoPi,
+		new(big.Float).SetInt(k)))
+	r, _ := x.Float64()
+	return r
+}
+
+func TestReduceAngleAccurate(t *testing.T) {
+	// mean longitude and mean anomaly of the Moon, (47.1) and (47.3) p. 338,
+	// and mean longitude of the Sun, (25.2) p. 163, at ±100,000 years
+	args := []struct{ a0, rate unit.Angle }{
+		{unit.AngleFromDeg(218.3164477), unit.AngleFromDeg(481267.88123421)},
+		{unit.AngleFromDeg(134.9633964), unit.AngleFromDeg(477198.8675055)},
+		{unit.AngleFromDeg(280.46646), unit.AngleFromDeg(36000.76983)},
+	}
+	for _, a := range args {
+		for _, T := range []float64{-1000, -999.99873, 1000, 999.12345} {
+			want := reduceBig(a.a0, a.rate, T)
+			got := base.ReduceAngleAccurate(a.a0, a.rate, T).Rad()
+			if got < 0 || got >= 2*math.Pi {
+				t.Fatal("out of range", got)
+			}
+			ulp := math.Nextafter(want, math.Inf(1)) - want
+			if e := math.Abs(got - want); e > 2*ulp {
+				t.Errorf("a0 %v rate %v T %v: error %.1f ulp",
+					a.a0, a.rate, T, e/ulp)
+			}
+		}
+	}
+}
@@ -24,7 +24,10 @@ func Illuminated(i unit.Angle) float64 {
 // The illuminated body can be the Moon or a planet.
 //
 // Arguments α, δ are equatorial coordinates of the body; α0, δ0 are
-// apparent coordinates of the Sun.
+// apparent coordinates of the Sun.  Result is in the range [0, 2π).
+//
+// This is the general position angle of (48.5) and (42.4); packages that
+// can import coord should use angle.PositionAngle.
 func Limb(α unit.RA, δ unit.Angle, α0 unit.RA, δ0 unit.Angle) unit.Angle {
 	// Mentioned in ch 41, p. 283.  Formula (48.5) p. 346
 	sδ, cδ := δ.Sincos()
@@ -36,3 +39,27 @@ func Limb(α unit.RA, δ unit.Angle, α0 unit.RA, δ0 unit.Angle) unit.Angle {
 	}
 	return χ
 }
+
+// IlluminatedSlice returns illuminated fractions for a slice of phase angles.
+//
+// Each element of the result is Illuminated of the corresponding element of i.
+func IlluminatedSlice(i []unit.Angle) []float64 {
+	k := make([]float64, len(i))
+	for n, in := range i {
+		k[n] = Illuminated(in)
+	}
+	return k
+}
+
+// LimbSlice returns position angles of bright limbs for slices of
+// coordinates.
+//
+// Arguments are as for Limb, with corresponding elements describing the body
+// and the Sun at the same time.  All slices must have the same length.
+func LimbSlice(α []unit.RA, δ []unit.Angle, α0 []unit.RA, δ0 []unit.Angle) []unit.Angle {
+	χ := make([]unit.Angle, len(α))
+	for n := range α {
+		χ[n] = Limb(α[n], δ[n], α0[n], δ0[n])
+	}
+	return χ
+}
@@ -56,3 +56,26 @@ func ExampleLimb() {
 	// Output:
 	// χ = 285.0
 }
+
+func ExampleIlluminatedSlice() {
+	// Examples 41.a, 48.a.
+	k := base.IlluminatedSlice([]unit.Angle{
+		unit.Angle(math.Acos(.29312)),
+		unit.AngleFromDeg(69.0756),
+	})
+	fmt.Printf("%.4f\n", k)
+	// Output:
+	// [0.6466 0.6786]
+}
+
+func ExampleLimbSlice() {
+	// Example 48.a, p. 347.
+	χ := base.LimbSlice(
+		[]unit.RA{unit.RAFromDeg(134.6885)},
+		[]unit.Angle{unit.AngleFromDeg(13.7684)},
+		[]unit.RA{unit.RAFromDeg(20.6579)},
+		[]unit.Angle{unit.AngleFromDeg(8.6964)})
+	fmt.Printf("χ = %.1f\n", χ[0].Deg())
+	// Output:
+	// χ = 285.0
+}
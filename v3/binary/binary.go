@@ -7,6 +7,7 @@ package binary
 import (
 	"math"
 
+	"github.com/soniakeys/meeus/v3/kepler"
 	"github.com/soniakeys/unit"
 )
 
@@ -34,7 +35,7 @@ func M(year, T, P float64) unit.Angle {
 // Return value θ is the apparent position angle, ρ is the angular distance.
 func Position(e float64, a, i, Ω, ω, E unit.Angle) (θ, ρ unit.Angle) {
 	r := a.Mul(1 - e*E.Cos())
-	ν := unit.Angle(2 * math.Atan(math.Sqrt((1+e)/(1-e))*E.Div(2).Tan()))
+	ν := kepler.True(E, e)
 	sνω, cνω := (ν + ω).Sincos()
 	ci := i.Cos()
 	num := sνω * ci
@@ -43,6 +44,61 @@ func Position(e float64, a, i, Ω, ω, E unit.Angle) (θ, ρ unit.Angle) {
 	return
 }
 
+// PositionAt computes apparent position angle and angular distance of
+// components of a binary star at a given date.
+//
+//	year is a decimal year specifying the date
+//	T, P are time of periastron and period as for function M
+//	e, a, i, Ω, ω are orbital elements as for function Position
+//
+// Kepler's equation is solved with kepler.Kepler3, which converges for
+// any eccentricity.
+func PositionAt(year, T, P, e float64, a, i, Ω, ω unit.Angle) (θ, ρ unit.Angle) {
+	return Position(e, a, i, Ω, ω, kepler.Kepler3(e, M(year, T, P)))
+}
+
+// Periastra returns times of periastron passage within a range of dates.
+//
+//	T is a time of periastron, as a decimal year
+//	P is period of revolution in mean solar years
+//	year1, year2 are decimal years bounding the range
+//
+// Result is times in the range [year1, year2), as decimal years.
+func Periastra(T, P, year1, year2 float64) []float64 {
+	return repeat(T, P, year1, year2)
+}
+
+// Nodes returns times of passage through the nodes within a range of dates.
+//
+// The nodes are the points where the orbit crosses the plane through the
+// primary perpendicular to the line of sight.
+//
+//	T, P, year1, year2 are as for Periastra
+//	e is eccentricity of the true orbit
+//	ω is longitude of periastron
+//
+// Results are times of passage through the ascending node, at position
+// angle Ω, and through the descending node, as decimal years.
+func Nodes(T, P, e float64, ω unit.Angle, year1, year2 float64) (asc, desc []float64) {
+	n := 2 * math.Pi / P
+	t := func(ν unit.Angle) float64 {
+		// inverse of kepler.True, and Kepler's equation
+		E := 2 * math.Atan(math.Sqrt((1-e)/(1+e))*ν.Div(2).Tan())
+		return T + (E-e*math.Sin(E))/n
+	}
+	asc = repeat(t(-ω), P, year1, year2)
+	desc = repeat(t(math.Pi-ω), P, year1, year2)
+	return
+}
+
+// repeat returns times t + k*P within [year1, year2) for integers k.
+func repeat(t, P, year1, year2 float64) (r []float64) {
+	for t += math.Ceil((year1-t)/P) * P; t < year2; t += P {
+		r = append(r, t)
+	}
+	return
+}
+
 // ApparentEccentricity returns apparent eccenticity of a binary star
 // given true orbital elements.
 //
@@ -59,3 +115,95 @@ func ApparentEccentricity(e float64, i, ω unit.Angle) float64 {
 	sD := math.Sqrt(d*d + 4*B*B)
 	return math.Sqrt(2 * sD / (A + C + sD))
 }
+
+// ApparentEllipse returns the geometry of the apparent orbit of a binary star
+// given true orbital elements.
+//
+//	e is eccentricity of the true orbit
+//	a is angular apparent semimajor axis
+//	i is inclination relative to the line of sight
+//	Ω is position angle of the ascending node
+//	ω is longitude of periastron
+//
+// The apparent orbit is the projection of the true orbit on the plane of the
+// sky, an ellipse with the primary generally not at its focus.  Results are
+// the position angle θc and angular distance ρc of the center of the
+// apparent ellipse from the primary, the apparent semimajor and semiminor
+// axes sa and sb, and the position angle φ of the major axis, in the range
+// [0, π).
+//
+// The apparent eccentricity, sqrt(1 - sb²/sa²), equals the result of
+// ApparentEccentricity.
+func ApparentEllipse(e float64, a, i, Ω, ω unit.Angle) (θc, ρc, sa, sb, φ unit.Angle) {
+	// Thiele-Innes constants, with x toward north and y toward east.
+	sΩ, cΩ := Ω.Sincos()
+	sω, cω := ω.Sincos()
+	ci := i.Cos()
+	A := cω*cΩ - sω*sΩ*ci
+	B := cω*sΩ + sω*cΩ*ci
+	F := -sω*cΩ - cω*sΩ*ci
+	G := -sω*sΩ + cω*cΩ*ci
+	// The true orbit is centered at -ae on the line of apsides.
+	θc = unit.Angle(math.Atan2(-B, -A)).Mod1()
+	ρc = a.Mul(e * math.Hypot(A, B))
+	// (A, B) and √(1-e²)(F, G) are conjugate semidiameters of the apparent
+	// ellipse.  The principal axes are found from the symmetric matrix
+	// formed from them.
+	q := math.Sqrt(1 - e*e)
+	F *= q
+	G *= q
+	sxx := A*A + F*F
+	syy := B*B + G*G
+	sxy := A*B + F*G
+	h := math.Hypot(sxx-syy, 2*sxy)
+	sa = a.Mul(math.Sqrt((sxx + syy + h) / 2))
+	sb = a.Mul(math.Sqrt((sxx + syy - h) / 2))
+	φ = unit.Angle(math.Atan2(2*sxy, sxx-syy) / 2)
+	if φ < 0 {
+		φ += math.Pi
+	}
+	return
+}
+
+// Mass returns the total mass of a binary system by Kepler's third law.
+//
+//	P is period of revolution in mean solar years
+//	a is angular apparent semimajor axis
+//	π is parallax of the system
+//
+// Result is the sum of the masses of the components, in solar masses.
+func Mass(P float64, a, π unit.Angle) float64 {
+	r := a.Rad() / π.Rad() // semimajor axis in AU
+	return r * r * r / (P * P)
+}
+
+// Parallax returns the parallax of a binary system of known total mass,
+// the orbital or dynamical parallax.
+//
+//	P is period of revolution in mean solar years
+//	a is angular apparent semimajor axis
+//	M is the sum of the masses of the components, in solar masses
+func Parallax(P float64, a unit.Angle, M float64) unit.Angle {
+	return a.Mul(1 / math.Cbrt(M*P*P))
+}
+
+// SemimajorAxis returns the angular semimajor axis of a binary system of
+// known total mass and parallax.
+//
+//	P is period of revolution in mean solar years
+//	M is the sum of the masses of the components, in solar masses
+//	π is parallax of the system
+func SemimajorAxis(P, M float64, π unit.Angle) unit.Angle {
+	return π.Mul(math.Cbrt(M * P * P))
+}
+
+// Period returns the period of revolution of a binary system of known total
+// mass and parallax, in mean solar years.
+//
+//	a is angular apparent semimajor axis
+//	M is the sum of the masses of the components, in solar masses
+//	π is parallax of the system
+func Period(a unit.Angle, M float64, π unit.Angle) float64 {
+	r := a.Rad() / π.Rad()
+	return math.Sqrt(r * r * r / M)
+}
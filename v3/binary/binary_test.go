@@ -5,6 +5,8 @@ package binary_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
 
 	"github.com/soniakeys/meeus/v3/binary"
 	"github.com/soniakeys/meeus/v3/kepler"
@@ -33,6 +35,52 @@ func ExamplePosition() {
 	// ρ = 0.411
 }
 
+func ExamplePositionAt() {
+	// Example 57.a, p. 398
+	θ, ρ := binary.PositionAt(1980, 1934.008, 41.623, .2763,
+		unit.AngleFromSec(.907), unit.AngleFromDeg(59.025),
+		unit.AngleFromDeg(23.717), unit.AngleFromDeg(219.907))
+	fmt.Printf("θ = %.1f\n", θ.Deg())
+	fmt.Printf("ρ = %.3f\n", ρ.Sec())
+	// Output:
+	// θ = 318.4
+	// ρ = 0.411
+}
+
+func ExampleNodes() {
+	// Elements of η Coronae Borealis, example 57.a, p. 398
+	const T, P, e = 1934.008, 41.623, .2763
+	ω := unit.AngleFromDeg(219.907)
+	fmt.Printf("periastron: %.3f\n", binary.Periastra(T, P, 1900, 2050))
+	asc, desc := binary.Nodes(T, P, e, ω, 1900, 2050)
+	fmt.Printf("ascending:  %.3f\n", asc)
+	fmt.Printf("descending: %.3f\n", desc)
+	// Output:
+	// periastron: [1934.008 1975.631 2017.254]
+	// ascending:  [1905.812 1947.435 1989.058 2030.681]
+	// descending: [1931.404 1973.027 2014.650]
+}
+
+// Position angle at the nodes is Ω and Ω+π.
+func TestNodes(t *testing.T) {
+	const T, P, e = 1934.008, 41.623, .2763
+	a := unit.AngleFromSec(.907)
+	i := unit.AngleFromDeg(59.025)
+	Ω := unit.AngleFromDeg(23.717)
+	ω := unit.AngleFromDeg(219.907)
+	asc, desc := binary.Nodes(T, P, e, ω, 1900, 2050)
+	for _, y := range asc {
+		if θ, _ := binary.PositionAt(y, T, P, e, a, i, Ω, ω); math.Abs((θ - Ω).Rad()) > 1e-9 {
+			t.Errorf("ascending node %.3f: θ = %.6f", y, θ.Deg())
+		}
+	}
+	for _, y := range desc {
+		if θ, _ := binary.PositionAt(y, T, P, e, a, i, Ω, ω); math.Abs((θ-Ω).Rad()-math.Pi) > 1e-9 {
+			t.Errorf("descending node %.3f: θ = %.6f", y, θ.Deg())
+		}
+	}
+}
+
 func ExampleApparentEccentricity() {
 	// Example 57.b, p. 400
 	fmt.Printf("%.3f\n", binary.ApparentEccentricity(.2763,
@@ -40,3 +88,60 @@ func ExampleApparentEccentricity() {
 	// Output:
 	// 0.860
 }
+
+func ExampleApparentEllipse() {
+	// Elements of η Coronae Borealis, example 57.a, p. 398
+	θc, ρc, sa, sb, φ := binary.ApparentEllipse(.2763,
+		unit.AngleFromSec(.907), unit.AngleFromDeg(59.025),
+		unit.AngleFromDeg(23.717), unit.AngleFromDeg(219.907))
+	fmt.Printf("center:     θ = %.1f°  ρ = %.3f″\n", θc.Deg(), ρc.Sec())
+	fmt.Printf("semi-axes:  %.3f″  %.3f″\n", sa.Sec(), sb.Sec())
+	fmt.Printf("major axis: %.1f°\n", φ.Deg())
+	fmt.Printf("e′ = %.3f\n", math.Sqrt(1-sb.Sec()*sb.Sec()/(sa.Sec()*sa.Sec())))
+	// Output:
+	// center:     θ = 47.0°  ρ = 0.209″
+	// semi-axes:  0.893″  0.456″
+	// major axis: 25.3°
+	// e′ = 0.860
+}
+
+// Positions computed over a revolution lie on the apparent ellipse.
+func TestApparentEllipse(t *testing.T) {
+	const T, P, e = 1934.008, 41.623, .2763
+	a := unit.AngleFromSec(.907)
+	i := unit.AngleFromDeg(59.025)
+	Ω := unit.AngleFromDeg(23.717)
+	ω := unit.AngleFromDeg(219.907)
+	θc, ρc, sa, sb, φ := binary.ApparentEllipse(e, a, i, Ω, ω)
+	xc := ρc.Rad() * θc.Cos()
+	yc := ρc.Rad() * θc.Sin()
+	sφ, cφ := φ.Sincos()
+	for y := T; y < T+P; y += P / 17 {
+		θ, ρ := binary.PositionAt(y, T, P, e, a, i, Ω, ω)
+		x := ρ.Rad()*θ.Cos() - xc
+		z := ρ.Rad()*θ.Sin() - yc
+		u := (x*cφ + z*sφ) / sa.Rad()
+		v := (-x*sφ + z*cφ) / sb.Rad()
+		if r := u*u + v*v; math.Abs(r-1) > 1e-9 {
+			t.Errorf("%.3f: %.12f", y, r)
+		}
+	}
+}
+
+func ExampleMass() {
+	// Elements of η Coronae Borealis, example 57.a, p. 398, with a
+	// parallax of 0″.0559.
+	P := 41.623
+	a := unit.AngleFromSec(.907)
+	π := unit.AngleFromSec(.0559)
+	M := binary.Mass(P, a, π)
+	fmt.Printf("M = %.2f solar masses\n", M)
+	fmt.Printf("π = %.4f″\n", binary.Parallax(P, a, M).Sec())
+	fmt.Printf("a = %.3f″\n", binary.SemimajorAxis(P, M, π).Sec())
+	fmt.Printf("P = %.3f years\n", binary.Period(a, M, π))
+	// Output:
+	// M = 2.47 solar masses
+	// π = 0.0559″
+	// a = 0.907″
+	// P = 41.623 years
+}
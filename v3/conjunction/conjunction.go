@@ -6,6 +6,7 @@ package conjunction
 
 import (
 	"errors"
+	"math"
 
 	"github.com/soniakeys/meeus/v3/interp"
 	"github.com/soniakeys/unit"
@@ -73,3 +74,92 @@ func conj(t1, t5 float64, dr, dd []float64) (t float64, Δd unit.Angle, err erro
 	ΔdRad, err := l5.InterpolateXStrict(t)
 	return t, unit.Angle(ΔdRad), err
 }
+
+// Detail holds quantities describing the relative position and motion of
+// two objects at the time of a conjunction.
+//
+// Angles are position angles measured from north through east, in the frame
+// of the ephemerides.  Rates are per unit of the time scale of t1, t5.
+type Detail struct {
+	PA      unit.Angle // position angle of object 2 relative to object 1
+	Motion  unit.Angle // position angle of the motion of object 2 relative to object 1
+	Speed   unit.Angle // rate of relative motion
+	SepRate unit.Angle // rate of change of separation, negative if approaching
+}
+
+// PlanetaryDetail computes a conjunction between two moving objects as
+// Planetary, and additionally relative position and motion at the time of
+// conjunction.
+//
+// Arguments and return values t, Δd are as for Planetary.  As the difference
+// in the first coordinate is zero at conjunction, PA is 0 or π, with object 2
+// north or south of object 1.  SepRate tells whether the objects are still
+// approaching, as with an appulse yet to come, or receding.
+func PlanetaryDetail(t1, t5 float64, r1, d1, r2, d2 []unit.Angle) (t float64, Δd unit.Angle, d Detail, err error) {
+	if len(r1) != 5 || len(d1) != 5 || len(r2) != 5 || len(d2) != 5 {
+		err = errors.New("Five rows required in ephemerides")
+		return
+	}
+	dr := make([]float64, 5, 15)
+	dd := dr[5:10]
+	δ1 := dr[10:15]
+	for i, r := range r1 {
+		dr[i] = (r2[i] - r).Rad()
+		dd[i] = (d2[i] - d1[i]).Rad()
+		δ1[i] = d1[i].Rad()
+	}
+	return conjDetail(t1, t5, dr, dd, δ1)
+}
+
+// StellarDetail computes a conjunction between a moving and non-moving
+// object as Stellar, and additionally relative position and motion at the
+// time of conjunction.
+//
+// Arguments and return values are as for Stellar and PlanetaryDetail.
+func StellarDetail(t1, t5 float64, r1, d1 unit.Angle, r2, d2 []unit.Angle) (t float64, Δd unit.Angle, d Detail, err error) {
+	if len(r2) != 5 || len(d2) != 5 {
+		err = errors.New("Five rows required in ephemeris")
+		return
+	}
+	dr := make([]float64, 5, 15)
+	dd := dr[5:10]
+	δ1 := dr[10:15]
+	for i, r := range r2 {
+		dr[i] = (r - r1).Rad()
+		dd[i] = (d2[i] - d1).Rad()
+		δ1[i] = d1.Rad()
+	}
+	return conjDetail(t1, t5, dr, dd, δ1)
+}
+
+func conjDetail(t1, t5 float64, dr, dd, δ1 []float64) (t float64, Δd unit.Angle, d Detail, err error) {
+	if t, Δd, err = conj(t1, t5, dr, dd); err != nil {
+		return
+	}
+	var lr, ld, lδ *interp.Len5
+	if lr, err = interp.NewLen5(t1, t5, dr); err != nil {
+		return
+	}
+	if ld, err = interp.NewLen5(t1, t5, dd); err != nil {
+		return
+	}
+	if lδ, err = interp.NewLen5(t1, t5, δ1); err != nil {
+		return
+	}
+	// relative velocity, x toward east, y toward north.  relative position
+	// at conjunction is entirely in y.
+	cδ := math.Cos(lδ.InterpolateX(t))
+	y := Δd.Rad()
+	h := (t5 - t1) * 1e-4
+	vx := (lr.InterpolateX(t+h) - lr.InterpolateX(t-h)) / (2 * h) * cδ
+	vy := (ld.InterpolateX(t+h) - ld.InterpolateX(t-h)) / (2 * h)
+	if y < 0 {
+		d.PA = math.Pi
+		d.SepRate = unit.Angle(-vy)
+	} else {
+		d.SepRate = unit.Angle(vy)
+	}
+	d.Motion = unit.Angle(math.Atan2(vx, vy)).Mod1()
+	d.Speed = unit.Angle(math.Hypot(vx, vy))
+	return
+}
@@ -139,3 +139,89 @@ func ExampleStellar() {
 	// 3′38″
 	// 1996 February 18 at 6ʰ36ᵐ55ˢ TD
 }
+
+func ExamplePlanetaryDetail() {
+	// Data of Example 18.a, p. 117.
+	r1 := []unit.Angle{
+		unit.NewRA(10, 27, 27.175).Angle(),
+		unit.NewRA(10, 26, 32.410).Angle(),
+		unit.NewRA(10, 25, 29.042).Angle(),
+		unit.NewRA(10, 24, 17.191).Angle(),
+		unit.NewRA(10, 22, 57.024).Angle(),
+	}
+	d1 := []unit.Angle{
+		unit.NewAngle(' ', 4, 04, 41.83),
+		unit.NewAngle(' ', 3, 55, 54.66),
+		unit.NewAngle(' ', 3, 48, 03.51),
+		unit.NewAngle(' ', 3, 41, 10.25),
+		unit.NewAngle(' ', 3, 35, 16.61),
+	}
+	r2 := []unit.Angle{
+		unit.NewRA(10, 24, 30.125).Angle(),
+		unit.NewRA(10, 25, 00.342).Angle(),
+		unit.NewRA(10, 25, 12.515).Angle(),
+		unit.NewRA(10, 25, 06.235).Angle(),
+		unit.NewRA(10, 24, 41.185).Angle(),
+	}
+	d2 := []unit.Angle{
+		unit.NewAngle(' ', 6, 26, 32.05),
+		unit.NewAngle(' ', 6, 10, 57.72),
+		unit.NewAngle(' ', 5, 57, 33.08),
+		unit.NewAngle(' ', 5, 46, 27.07),
+		unit.NewAngle(' ', 5, 37, 48.45),
+	}
+	day, dd, d, err := conjunction.PlanetaryDetail(5, 9, r1, d1, r2, d2)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("1991 August %.5f\n", day)
+	fmt.Printf("Δδ = %s\n", sexa.FmtAngle(dd))
+	fmt.Printf("position angle %.1f°\n", d.PA.Deg())
+	fmt.Printf("motion toward %.1f°\n", d.Motion.Deg())
+	fmt.Printf("speed %.4f°/day\n", d.Speed.Deg())
+	fmt.Printf("separation rate %+.4f°/day\n", d.SepRate.Deg())
+	// Output:
+	// 1991 August 7.23797
+	// Δδ = 2°8′22″
+	// position angle 0.0°
+	// motion toward 105.1°
+	// speed 0.2939°/day
+	// separation rate -0.0764°/day
+}
+
+func ExampleStellarDetail() {
+	// Data of exercise, p. 119.
+	r2 := []unit.Angle{
+		unit.NewRA(15, 3, 51.937).Angle(),
+		unit.NewRA(15, 9, 57.327).Angle(),
+		unit.NewRA(15, 15, 37.898).Angle(),
+		unit.NewRA(15, 20, 50.632).Angle(),
+		unit.NewRA(15, 25, 32.695).Angle(),
+	}
+	d2 := []unit.Angle{
+		unit.NewAngle('-', 8, 57, 34.51),
+		unit.NewAngle('-', 9, 9, 03.88),
+		unit.NewAngle('-', 9, 17, 37.94),
+		unit.NewAngle('-', 9, 23, 16.25),
+		unit.NewAngle('-', 9, 26, 01.01),
+	}
+	r1 := unit.NewRA(15, 17, 0.446).Angle()
+	d1 := unit.NewAngle('-', 9, 22, 58.47)
+	day, dd, d, err := conjunction.StellarDetail(7, 27, r1, d1, r2, d2)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("1996 February %.4f\n", day)
+	fmt.Printf("Δδ = %s\n", sexa.FmtAngle(dd))
+	fmt.Printf("position angle %.1f°\n", d.PA.Deg())
+	fmt.Printf("motion toward %.1f°\n", d.Motion.Deg())
+	fmt.Printf("separation rate %+.4f°/day\n", d.SepRate.Deg())
+	// Output:
+	// 1996 February 18.2756
+	// Δδ = 3′38″
+	// position angle 0.0°
+	// motion toward 94.6°
+	// separation rate -0.0212°/day
+}
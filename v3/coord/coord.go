@@ -19,9 +19,14 @@
 package coord
 
 import (
+	"encoding/json"
+	"errors"
 	"math"
+	"strconv"
 
+	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/sidereal"
 	"github.com/soniakeys/unit"
 )
 
@@ -77,6 +82,29 @@ type Equatorial struct {
 	Dec unit.Angle // Declination (δ)
 }
 
+// MarshalJSON encodes eq as a JSON object with members "ra" and "dec", in
+// degrees.
+func (eq Equatorial) MarshalJSON() ([]byte, error) {
+	return json.Marshal(eq.JSONValue(base.Degree))
+}
+
+// JSONValue returns a value for encoding eq with package encoding/json as
+// the object of MarshalJSON, with values in unit u.
+func (eq Equatorial) JSONValue(u base.AngleUnit) interface{} {
+	return struct {
+		RA  interface{} `json:"ra"`
+		Dec interface{} `json:"dec"`
+	}{u.JSONRA(eq.RA), u.JSONAngle(eq.Dec)}
+}
+
+// CSVHeader returns column names for the fields of CSVRecord.
+func (eq Equatorial) CSVHeader() []string { return []string{"ra", "dec"} }
+
+// CSVRecord formats eq as fields for package encoding/csv, in unit u.
+func (eq Equatorial) CSVRecord(u base.AngleUnit) []string {
+	return []string{u.CSVRA(eq.RA), u.CSVAngle(eq.Dec)}
+}
+
 // EclToEq converts ecliptic coordinates to equatorial coordinates.
 func (eq *Equatorial) EclToEq(ecl *Ecliptic, ε *Obliquity) *Equatorial {
 	eq.RA, eq.Dec = EclToEq(ecl.Lon, ecl.Lat, ε.S, ε.C)
@@ -116,7 +144,7 @@ func (eq *Equatorial) HzToEq(hz *Horizontal, g globe.Coord, st unit.Time) *Equat
 //	A: azimuth
 //	h: elevation
 //	φ: latitude of observer on Earth
-//	ψ: longitude of observer on Earth
+//	ψ: longitude of observer on Earth, positive west
 //	st: sidereal time at Greenwich at time of observation.
 //
 // Sidereal time must be consistent with the equatorial coordinates
@@ -176,6 +204,81 @@ func GalToEq(l, b unit.Angle) (α unit.RA, δ unit.Angle) {
 	return
 }
 
+// EquatorialEpoch holds equatorial coordinates together with the epoch of
+// the equinox to which they are referred.
+//
+// Methods of EquatorialEpoch check the epoch where a conversion requires
+// a particular one and return ErrorEpoch rather than silently mixing epochs.
+// See also package precess for changing the epoch.
+type EquatorialEpoch struct {
+	Equatorial
+	Epoch float64 // Julian year of the equinox, for example 2000 for J2000.0
+}
+
+// MarshalJSON encodes eq as a JSON object with members "ra", "dec", and
+// "epoch".
+//
+// The method overrides that of the embedded Equatorial so that the epoch is
+// not lost.  Angles are in degrees.
+func (eq EquatorialEpoch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(eq.JSONValue(base.Degree))
+}
+
+// JSONValue returns a value for encoding eq with package encoding/json as
+// the object of MarshalJSON, with angles in unit u.
+func (eq EquatorialEpoch) JSONValue(u base.AngleUnit) interface{} {
+	return struct {
+		RA    interface{} `json:"ra"`
+		Dec   interface{} `json:"dec"`
+		Epoch float64     `json:"epoch"`
+	}{u.JSONRA(eq.RA), u.JSONAngle(eq.Dec), eq.Epoch}
+}
+
+// CSVHeader returns column names for the fields of CSVRecord.
+func (eq EquatorialEpoch) CSVHeader() []string {
+	return []string{"ra", "dec", "epoch"}
+}
+
+// CSVRecord formats eq as fields for package encoding/csv, with angles in
+// unit u.
+func (eq EquatorialEpoch) CSVRecord(u base.AngleUnit) []string {
+	return append(eq.Equatorial.CSVRecord(u),
+		strconv.FormatFloat(eq.Epoch, 'f', -1, 64))
+}
+
+// EpochB1950 is the epoch of the standard equinox of B1950.0, as a Julian
+// year.
+var EpochB1950 = base.JDEToJulianYear(base.B1950)
+
+// ErrorEpoch is returned when coordinates are not referred to the epoch
+// required by a conversion.
+var ErrorEpoch = errors.New("coordinates not referred to required epoch")
+
+// SameEpoch returns true if eq is referred to epoch, within a tolerance of
+// a few minutes.
+func (eq *EquatorialEpoch) SameEpoch(epoch float64) bool {
+	return math.Abs(eq.Epoch-epoch) < 1e-5
+}
+
+// GalToEq converts galactic coordinates to equatorial coordinates referred
+// to the standard equinox of B1950.0, setting eq.Epoch accordingly.
+func (eq *EquatorialEpoch) GalToEq(g *Galactic) *EquatorialEpoch {
+	eq.Equatorial.GalToEq(g)
+	eq.Epoch = EpochB1950
+	return eq
+}
+
+// EqEpochToGal converts equatorial coordinates to galactic coordinates.
+//
+// ErrorEpoch is returned if eq is not referred to the standard equinox of
+// B1950.0.
+func (g *Galactic) EqEpochToGal(eq *EquatorialEpoch) (*Galactic, error) {
+	if !eq.SameEpoch(EpochB1950) {
+		return nil, ErrorEpoch
+	}
+	return g.EqToGal(&eq.Equatorial), nil
+}
+
 // Horizontal coordinates are referenced to the local horizon of an observer
 // on the surface of the Earth.
 type Horizontal struct {
@@ -183,6 +286,31 @@ type Horizontal struct {
 	Alt unit.Angle // Altitude (h)
 }
 
+// MarshalJSON encodes hz as a JSON object with members "az" and "alt".
+//
+// Values are in degrees.  Azimuth is encoded as is, measured westward from
+// the South.
+func (hz Horizontal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hz.JSONValue(base.Degree))
+}
+
+// JSONValue returns a value for encoding hz with package encoding/json as
+// the object of MarshalJSON, with values in unit u.
+func (hz Horizontal) JSONValue(u base.AngleUnit) interface{} {
+	return struct {
+		Az  interface{} `json:"az"`
+		Alt interface{} `json:"alt"`
+	}{u.JSONAngle(hz.Az), u.JSONAngle(hz.Alt)}
+}
+
+// CSVHeader returns column names for the fields of CSVRecord.
+func (hz Horizontal) CSVHeader() []string { return []string{"az", "alt"} }
+
+// CSVRecord formats hz as fields for package encoding/csv, in unit u.
+func (hz Horizontal) CSVRecord(u base.AngleUnit) []string {
+	return []string{u.CSVAngle(hz.Az), u.CSVAngle(hz.Alt)}
+}
+
 // EqToHz computes Horizontal coordinates from equatorial coordinates.
 //
 // Argument g is the location of the observer on the Earth.  Argument st
@@ -200,7 +328,7 @@ func (hz *Horizontal) EqToHz(eq *Equatorial, g *globe.Coord, st unit.Time) *Hori
 //	α: right ascension coordinate to transform
 //	δ: declination coordinate to transform
 //	φ: latitude of observer on Earth
-//	ψ: longitude of observer on Earth
+//	ψ: longitude of observer on Earth, positive west
 //	st: sidereal time at Greenwich at time of observation.
 //
 // Sidereal time must be consistent with the equatorial coordinates.
@@ -211,8 +339,7 @@ func (hz *Horizontal) EqToHz(eq *Equatorial, g *globe.Coord, st unit.Time) *Hori
 //	A: azimuth of observed point, measured westward from the South.
 //	h: elevation, or height of observed point above horizon.
 func EqToHz(α unit.RA, δ, φ, ψ unit.Angle, st unit.Time) (A, h unit.Angle) {
-	H := st.Rad() - ψ.Rad() - α.Rad()
-	sH, cH := math.Sincos(H)
+	sH, cH := sidereal.LocalHourAngle(st, ψ, α).Sincos()
 	sφ, cφ := φ.Sincos()
 	sδ, cδ := δ.Sincos()
 	A = unit.Angle(math.Atan2(sH, cH*sφ-(sδ/cδ)*cφ)) // (13.5) p. 93
@@ -220,6 +347,68 @@ func EqToHz(α unit.RA, δ, φ, ψ unit.Angle, st unit.Time) (A, h unit.Angle) {
 	return
 }
 
+// TransitAltitude returns the altitude of a body at upper transit.
+//
+// Argument φ is the latitude of the observer, δ the declination of the body.
+// Refraction is not considered.
+func TransitAltitude(φ, δ unit.Angle) unit.Angle {
+	return math.Pi/2 - unit.Angle(math.Abs((φ - δ).Rad()))
+}
+
+// LowerTransitAltitude returns the altitude of a body at lower transit.
+//
+// Argument φ is the latitude of the observer, δ the declination of the body.
+// Refraction is not considered.
+func LowerTransitAltitude(φ, δ unit.Angle) unit.Angle {
+	return unit.Angle(math.Abs((φ + δ).Rad())) - math.Pi/2
+}
+
+// Errors returned by HourAngleAtAltitude.
+var (
+	ErrorAlwaysAbove = errors.New("body always above altitude")
+	ErrorAlwaysBelow = errors.New("body always below altitude")
+)
+
+// HourAngleAtAltitude returns the hour angle at which a body reaches
+// altitude h.
+//
+// Argument φ is the latitude of the observer, δ the declination of the body.
+// The result H is in the range [0, π], the body being at altitude h at the
+// hour angles -H, rising, and +H, setting.  This is (15.1) p. 102 for h the
+// standard altitude h0.
+//
+// ErrorAlwaysAbove or ErrorAlwaysBelow is returned if the body does not
+// reach altitude h.
+func HourAngleAtAltitude(φ, δ, h unit.Angle) (H unit.HourAngle, err error) {
+	sφ, cφ := φ.Sincos()
+	sδ, cδ := δ.Sincos()
+	cH := (h.Sin() - sφ*sδ) / (cφ * cδ)
+	switch {
+	case cH < -1:
+		return 0, ErrorAlwaysAbove
+	case cH > 1:
+		return 0, ErrorAlwaysBelow
+	}
+	return unit.HourAngle(math.Acos(cH)), nil
+}
+
+// SemidiurnalArc returns half the hour angle interval during which a body
+// is above altitude h.
+//
+// Argument φ is the latitude of the observer, δ the declination of the body.
+// The result is that of HourAngleAtAltitude except that it is π for a
+// body always above h and 0 for a body always below h.
+func SemidiurnalArc(φ, δ, h unit.Angle) unit.HourAngle {
+	H, err := HourAngleAtAltitude(φ, δ, h)
+	switch err {
+	case ErrorAlwaysAbove:
+		return math.Pi
+	case ErrorAlwaysBelow:
+		return 0
+	}
+	return H
+}
+
 // Galactic coordinates are referenced to the plane of the Milky Way.
 type Galactic struct {
 	Lat unit.Angle // Latitude (b) in radians
@@ -4,6 +4,7 @@
 package coord_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -167,6 +168,33 @@ func ExampleHzToEq() {
 	// α = +23ʰ9ᵐ16ˢ.6, δ = -6°43′12″
 }
 
+func ExampleHourAngleAtAltitude() {
+	// Venus from Boston, example 15.a, p. 103.
+	φ := unit.NewAngle(' ', 42, 20, 0)
+	δ := unit.AngleFromDeg(18.44092)
+	h0 := unit.AngleFromMin(-34)
+	H0, err := coord.HourAngleAtAltitude(φ, δ, h0)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("H0 = %.4f°\n", unit.Angle(H0).Deg())
+	fmt.Printf("transit altitude %.4f°\n", coord.TransitAltitude(φ, δ).Deg())
+	fmt.Printf("lower transit altitude %.4f°\n",
+		coord.LowerTransitAltitude(φ, δ).Deg())
+	// A star of declination 60° never sets at this latitude.
+	_, err = coord.HourAngleAtAltitude(φ, unit.AngleFromDeg(60), h0)
+	fmt.Println(err)
+	fmt.Printf("semidiurnal arc %.0fh\n",
+		coord.SemidiurnalArc(φ, unit.AngleFromDeg(60), h0).Hour())
+	// Output:
+	// H0 = 108.5343°
+	// transit altitude 66.1076°
+	// lower transit altitude -29.2257°
+	// body always above altitude
+	// semidiurnal arc 12h
+}
+
 func ExampleGalactic_EqToGal() {
 	// Exercise, p. 96.
 	eq := &coord.Equatorial{
@@ -179,3 +207,46 @@ func ExampleGalactic_EqToGal() {
 	// Output:
 	// l = 12°.9593, b = +6°.0463
 }
+
+func ExampleGalactic_EqEpochToGal() {
+	// Exercise, p. 96.
+	eq := &coord.EquatorialEpoch{
+		Equatorial: coord.Equatorial{
+			RA:  unit.NewRA(17, 48, 59.74),
+			Dec: unit.NewAngle('-', 14, 43, 8.2),
+		},
+		Epoch: coord.EpochB1950,
+	}
+	g, err := new(coord.Galactic).EqEpochToGal(eq)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("l = %.4j, b = %+.4j\n",
+		sexa.FmtAngle(g.Lon), sexa.FmtAngle(g.Lat))
+	// J2000 coordinates are refused.
+	eq.Epoch = 2000
+	_, err = new(coord.Galactic).EqEpochToGal(eq)
+	fmt.Println(err)
+	// Output:
+	// l = 12°.9593, b = +6°.0463
+	// coordinates not referred to required epoch
+}
+
+func ExampleEquatorial_JSONValue() {
+	// Coordinates of Venus from example 13.b, p. 95.
+	eq := coord.Equatorial{
+		RA:  unit.NewRA(23, 9, 16.641),
+		Dec: unit.NewAngle('-', 6, 43, 11.61),
+	}
+	b, err := json.Marshal(eq.JSONValue(base.Sexagesimal))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(b))
+	fmt.Println(eq.CSVHeader(), eq.CSVRecord(base.Sexagesimal))
+	// Output:
+	// {"ra":"23:09:16.641","dec":"-06:43:11.61"}
+	// [ra dec] [23:09:16.641 -06:43:11.61]
+}
@@ -0,0 +1,149 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+// Crescent: Visibility of the young lunar crescent.
+//
+// This package does not correspond to a chapter of the book.  It implements
+// the q-test of B. D. Yallop, "A Method for Predicting the First Sighting of
+// the New Crescent Moon", NAO Technical Note No. 69, 1997.  The test predicts
+// whether the crescent can be seen on the evening following a new moon and
+// so is of use for lunar calendars such as those of package jm.
+//
+// Following Yallop, ARCL, ARCV, and DAZ are computed from airless geocentric
+// positions at the "best time" of observation, sunset plus four ninths of the
+// lag.  The crescent width W is computed from the topocentric semidiameter.
+package crescent
+
+import (
+	"errors"
+	"math"
+
+	"github.com/soniakeys/meeus/v3/angle"
+	"github.com/soniakeys/meeus/v3/coord"
+	"github.com/soniakeys/meeus/v3/deltat"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/nutation"
+	"github.com/soniakeys/meeus/v3/parallax"
+	"github.com/soniakeys/meeus/v3/rise"
+	"github.com/soniakeys/meeus/v3/sidereal"
+	"github.com/soniakeys/meeus/v3/solar"
+	"github.com/soniakeys/unit"
+)
+
+// ErrorMoonset is returned by Yallop when the Moon sets before the Sun.
+var ErrorMoonset = errors.New("Moon sets before Sun")
+
+// Visibility holds the quantities of Yallop's q-test.
+type Visibility struct {
+	Sunset  unit.Time  // UT of sunset, seconds of day
+	Moonset unit.Time  // UT of moonset, seconds from 0h of the day of sunset
+	Lag     unit.Time  // moonset minus sunset
+	Best    float64    // JD (UT) of best time of observation
+	ARCL    unit.Angle // elongation of the Moon from the Sun
+	ARCV    unit.Angle // difference in altitude of the Moon and Sun
+	DAZ     unit.Angle // difference in azimuth of the Sun and Moon
+	W       unit.Angle // topocentric width of the crescent
+	Q       float64    // test parameter q
+	Code    byte       // visibility code, 'A' through 'F'
+}
+
+// Yallop computes the q-test for the evening of the given Gregorian date
+// as seen by an observer at p.  Longitude p.Lon is positive west.
+//
+// When the Moon sets before the Sun, the Visibility returned has Sunset,
+// Moonset and Lag computed and err is ErrorMoonset.  rise.ErrorCircumpolar
+// may also be returned for either body.
+func Yallop(yr, mon, day int, p globe.Coord) (v *Visibility, err error) {
+	jd0 := julian.CalendarGregorianToJD(yr, mon, float64(day))
+	ΔT := deltat.Interp10A(jd0)
+	Th0 := sidereal.Apparent0UT(jd0)
+	α := make([]unit.RA, 3)
+	δ := make([]unit.Angle, 3)
+	for i := range α {
+		α[i], δ[i] = solar.ApparentEquatorial(jd0 + float64(i-1))
+	}
+	v = &Visibility{}
+	if _, _, v.Sunset, err = rise.Times(p, ΔT, rise.Stdh0Solar, Th0,
+		α, δ); err != nil {
+		return nil, err
+	}
+	var Δ float64
+	for i := range α {
+		α[i], δ[i], Δ = moon(jd0 + float64(i-1))
+	}
+	h0 := rise.Stdh0Lunar(parallax.HorizontalKm(Δ))
+	if _, _, v.Moonset, err = rise.Times(p, ΔT, h0, Th0, α, δ); err != nil {
+		return nil, err
+	}
+	// choose the moonset nearest sunset
+	switch {
+	case v.Moonset < v.Sunset-43200:
+		v.Moonset += 86400
+	case v.Moonset >= v.Sunset+43200:
+		v.Moonset -= 86400
+	}
+	v.Lag = v.Moonset - v.Sunset
+	if v.Lag < 0 {
+		return v, ErrorMoonset
+	}
+	v.Best = jd0 + (v.Sunset + v.Lag.Mul(4./9)).Day()
+	jde := v.Best + ΔT.Day()
+	st := sidereal.Apparent(v.Best)
+	αs, δs := solar.ApparentEquatorial(jde)
+	αm, δm, Δ := moon(jde)
+	As, hs := coord.EqToHz(αs, δs, p.Lat, p.Lon, st)
+	Am, hm := coord.EqToHz(αm, δm, p.Lat, p.Lon, st)
+	v.ARCL = angle.Sep(unit.Angle(αs), δs, unit.Angle(αm), δm)
+	v.ARCV = hm - hs
+	v.DAZ = As - Am
+	sπ := parallax.HorizontalKm(Δ).Sin()
+	SD := math.Asin(.272481 * sπ)
+	SDʹ := SD * (1 + hm.Sin()*sπ)
+	v.W = unit.Angle(SDʹ * (1 - v.ARCL.Cos()))
+	v.Q = Q(v.ARCV, v.W)
+	v.Code = Code(v.Q)
+	return
+}
+
+// moon returns apparent equatorial coordinates and distance in km of the
+// Moon.
+func moon(jde float64) (unit.RA, unit.Angle, float64) {
+	λ, β, Δ := moonposition.Position(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	sε, cε := (nutation.MeanObliquity(jde) + Δε).Sincos()
+	α, δ := coord.EclToEq(λ+Δψ, β, sε, cε)
+	return α, δ, Δ
+}
+
+// Q returns Yallop's test parameter q given ARCV and crescent width W.
+func Q(ARCV, W unit.Angle) float64 {
+	w := W.Min()
+	return (ARCV.Deg() -
+		(11.8371 - 6.3226*w + .7319*w*w - .1018*w*w*w)) / 10
+}
+
+// Code returns the visibility code for test parameter q.
+//
+//	A  Easily visible to the naked eye.
+//	B  Visible under perfect conditions.
+//	C  May need optical aid to find the crescent.
+//	D  Will need optical aid to find the crescent.
+//	E  Not visible with a telescope.
+//	F  Not visible, below the Danjon limit.
+func Code(q float64) byte {
+	switch {
+	case q > .216:
+		return 'A'
+	case q > -.014:
+		return 'B'
+	case q > -.160:
+		return 'C'
+	case q > -.232:
+		return 'D'
+	case q > -.293:
+		return 'E'
+	}
+	return 'F'
+}
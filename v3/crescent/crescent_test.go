@@ -0,0 +1,39 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package crescent_test
+
+import (
+	"fmt"
+
+	"github.com/soniakeys/meeus/v3/crescent"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/unit"
+)
+
+func ExampleYallop() {
+	// Evening of the new moon of 1977 Feb 18, example 49.a p. 353,
+	// for an observer at Mecca.
+	p := globe.Coord{
+		Lat: unit.AngleFromDeg(21.42),
+		Lon: unit.AngleFromDeg(-39.83),
+	}
+	v, err := crescent.Yallop(1977, 2, 18, p)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Sunset  %.0fm UT\n", v.Sunset.Min())
+	fmt.Printf("Lag     %.0fm\n", v.Lag.Min())
+	fmt.Printf("ARCL    %.2f°\n", v.ARCL.Deg())
+	fmt.Printf("ARCV    %.2f°\n", v.ARCV.Deg())
+	fmt.Printf("W       %.2f′\n", v.W.Min())
+	fmt.Printf("q       %+.3f  %c\n", v.Q, v.Code)
+	// Output:
+	// Sunset  920m UT
+	// Lag     22m
+	// ARCL    7.19°
+	// ARCV    6.09°
+	// W       0.12′
+	// q       -0.499  F
+}
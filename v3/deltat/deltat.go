@@ -20,6 +20,8 @@
 package deltat
 
 import (
+	"errors"
+
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/interp"
 	"github.com/soniakeys/meeus/v3/julian"
@@ -57,8 +59,9 @@ var (
 		63.8, 64.3, 64.6, 64.8, 65.5, 66.1}
 )
 
-// Interp10A returns ΔT at a date, accurate from years 1620 to 2010.
-func Interp10A(jde float64) (ΔT unit.Time) {
+// calendarYear returns the decimal calendar year of a jde, as used to
+// index Table 10.A.
+func calendarYear(jde float64) float64 {
 	// kind of crazy, working in calendar years, but it seems that's what
 	// we're supposed to do.
 	y, m, d := julian.JDToCalendar(jde)
@@ -67,7 +70,27 @@ func Interp10A(jde float64) (ΔT unit.Time) {
 	if l {
 		yl++
 	}
-	yf := float64(y) + float64(julian.DayOfYear(y, m, int(d+.5), l))/yl
+	return float64(y) + float64(julian.DayOfYear(y, m, int(d+.5), l))/yl
+}
+
+// JDE returns the Julian ephemeris day corresponding to Julian day jd
+// in universal time, using ΔT from Interp10A.
+func JDE(jd base.JDUT) base.JDE {
+	return jd.TT(Interp10A(float64(jd)))
+}
+
+// JDUT returns the Julian day in universal time corresponding to Julian
+// ephemeris day jde, using ΔT from Interp10A.
+func JDUT(jde base.JDE) base.JDUT {
+	return jde.UT(Interp10A(float64(jde)))
+}
+
+// Interp10A returns ΔT at a date, accurate from years 1620 to 2010.
+//
+// Outside of that range the result is extrapolated from the first or last
+// rows of the table.  See Interp10AStrict.
+func Interp10A(jde float64) (ΔT unit.Time) {
+	yf := calendarYear(jde)
 	d3, err := interp.Len3ForInterpolateX(yf, tableYear1, tableYearN, table10A)
 	if err != nil {
 		panic(err) // error would indicate a bug in interp.Slice.
@@ -75,6 +98,131 @@ func Interp10A(jde float64) (ΔT unit.Time) {
 	return unit.Time(d3.InterpolateX(yf))
 }
 
+// ErrorOutsideTable is returned by Interp10AStrict for dates outside the
+// range of Table 10.A.
+var ErrorOutsideTable = errors.New("date outside range of Table 10.A")
+
+// Table10ARange returns the range of dates covered by Table 10.A.
+//
+// Results are Julian days of the beginnings of the first and last calendar
+// years of the table.
+func Table10ARange() (jd1, jdN float64) {
+	return julian.CalendarGregorianToJD(int(tableYear1), 1, 1),
+		julian.CalendarGregorianToJD(int(tableYearN), 1, 1)
+}
+
+// Interp10AStrict is like Interp10A but returns ErrorOutsideTable rather
+// than extrapolating for dates outside the range of Table 10.A.
+//
+// Programs can use the error to decide to obtain ΔT from some other source
+// such as current IERS data.
+func Interp10AStrict(jde float64) (ΔT unit.Time, err error) {
+	if jd1, jdN := Table10ARange(); jde < jd1 || jde > jdN {
+		return 0, ErrorOutsideTable
+	}
+	return Interp10A(jde), nil
+}
+
+// PolyUncertainty returns an estimate of the uncertainty in ΔT for calendar
+// year, for use with the polynomial extrapolations outside the range of
+// Table 10.A.
+//
+// The estimate is that of Morrison and Stephenson, "Historical values of
+// the Earth's clock error ΔT and the calculation of eclipses", JHA 35
+// (2004), and is based on the scatter of historical observations about the
+// parabola of long term tidal braking.
+func PolyUncertainty(year float64) unit.Time {
+	t := (year - 1820) * .01
+	return unit.Time(.8 * t * t)
+}
+
+// TidalAcceleration is the value of the secular acceleration of the Moon,
+// ṅ, in arcseconds per century squared, assumed by the long term ΔT
+// expressions of this package and of Morrison and Stephenson.
+const TidalAcceleration = -26.
+
+// TidalCorrection returns the correction to ΔT for calendar year year when
+// a value ṅ other than TidalAcceleration is used for the secular
+// acceleration of the Moon.
+//
+// ΔT deduced from ancient eclipses depends on the lunar theory used to
+// compute them.  When computing historical eclipses with a lunar theory
+// having a different ṅ, for example -25.858 for ELP 2000-82 and the lunar
+// theory of chapter 47, the correction should be added to ΔT from the long
+// term polynomials so that the eclipses are reproduced consistently.
+//
+// The correction is -0.91072 (ṅ + 26) u², with u in centuries from 1955,
+// as given by Morrison and Stephenson (2004).  Argument ṅ is in arcseconds
+// per century squared.
+func TidalCorrection(year, ṅ float64) unit.Time {
+	u := (year - 1955) * .01
+	return unit.Time(-.91072 * (ṅ - TidalAcceleration) * u * u)
+}
+
+// spline10A holds second derivatives of a natural cubic spline through
+// the values of table10A.
+var spline10A = func() []float64 {
+	// Tridiagonal system for uniformly spaced knots, solved with the
+	// Thomas algorithm.  End values are zero for the natural spline.
+	n := len(table10A)
+	h := (tableYearN - tableYear1) / float64(n-1)
+	m := make([]float64, n)
+	c := make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		r := 6 / (h * h) * (table10A[i+1] - 2*table10A[i] + table10A[i-1])
+		w := 4 - c[i-1]
+		c[i] = 1 / w
+		m[i] = (r - m[i-1]) / w
+	}
+	for i := n - 3; i > 0; i-- {
+		m[i] -= c[i] * m[i+1]
+	}
+	return m
+}()
+
+// spline10ASegment returns the spline segment of table10A containing
+// calendar year y.
+//
+// Results are the interval h, t the offset of y into the segment, and the
+// coefficients of the segment cubic in t.
+func spline10ASegment(y float64) (h, t, a, b, c, d float64) {
+	n := len(table10A)
+	h = (tableYearN - tableYear1) / float64(n-1)
+	i := int((y - tableYear1) / h)
+	if i < 0 {
+		i = 0
+	} else if i > n-2 {
+		i = n - 2
+	}
+	t = y - (tableYear1 + float64(i)*h)
+	m0, m1 := spline10A[i], spline10A[i+1]
+	a = table10A[i]
+	b = (table10A[i+1]-table10A[i])/h - h*(2*m0+m1)/6
+	c = m0 / 2
+	d = (m1 - m0) / (6 * h)
+	return
+}
+
+// Spline10A returns ΔT at a date, accurate from years 1620 to 2010.
+//
+// Unlike Interp10A, which interpolates separate parabolas through three
+// table values at a time, Spline10A interpolates a single natural cubic
+// spline through all values of Table 10.A.  The result and its first and
+// second derivatives are continuous across table rows.
+func Spline10A(jde float64) (ΔT unit.Time) {
+	_, t, a, b, c, d := spline10ASegment(calendarYear(jde))
+	return unit.Time(base.Horner(t, a, b, c, d))
+}
+
+// Spline10ARate returns the rate of change of ΔT at a date, as the
+// derivative of the spline of Spline10A.
+//
+// Result is in seconds per year.
+func Spline10ARate(jde float64) float64 {
+	_, t, _, b, c, d := spline10ASegment(calendarYear(jde))
+	return base.Horner(t, b, 2*c, 3*d)
+}
+
 // c2000 returns centuries from calendar year 2000.0.
 //
 // Arg should be a calendar year.
@@ -24,6 +24,59 @@ func ExampleInterp10A() {
 	// +47.6 seconds
 }
 
+func ExampleInterp10AStrict() {
+	for _, y := range []int{1977, 2020} {
+		dt, err := deltat.Interp10AStrict(julian.CalendarGregorianToJD(y, 1, 1))
+		if err != nil {
+			fmt.Println(y, err)
+			continue
+		}
+		fmt.Printf("%d %+.1f seconds\n", y, dt)
+	}
+	// Output:
+	// 1977 +47.5 seconds
+	// 2020 date outside range of Table 10.A
+}
+
+func ExamplePolyUncertainty() {
+	for _, y := range []float64{-500, 500} {
+		fmt.Printf("%4.0f  %+5.0f ± %.0f seconds\n", y,
+			deltat.PolyBefore948(y), deltat.PolyUncertainty(y))
+	}
+	for _, y := range []float64{1000, 1500} {
+		fmt.Printf("%4.0f  %+5.0f ± %.0f seconds\n", y,
+			deltat.Poly948to1600(y), deltat.PolyUncertainty(y))
+	}
+	// Output:
+	// -500  +17314 ± 431 seconds
+	//  500  +4644 ± 139 seconds
+	// 1000  +1612 ± 54 seconds
+	// 1500   +224 ± 8 seconds
+}
+
+func TestTable10ARange(t *testing.T) {
+	jd1, jdN := deltat.Table10ARange()
+	if _, err := deltat.Interp10AStrict(jd1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := deltat.Interp10AStrict(jdN); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := deltat.Interp10AStrict(jd1 - 1); err != deltat.ErrorOutsideTable {
+		t.Fatal("expected ErrorOutsideTable")
+	}
+}
+
+func ExampleJDUT() {
+	// Example 10.a, p. 78.
+	jde := base.JDE(julian.TimeToJD(
+		time.Date(1977, 2, 18, 3, 37, 40, 0, time.UTC)))
+	jd := deltat.JDUT(jde)
+	fmt.Println(julian.JDToTime(float64(jd)).Format("15:04:05"))
+	// Output:
+	// 03:36:52
+}
+
 func ExamplePoly1900to1997() {
 	// Example 10.a, p. 78.
 	jd := julian.TimeToJD(time.Date(1977, 2, 18, 3, 37, 40, 0, time.UTC))
@@ -47,6 +100,20 @@ func ExamplePolyBefore948() {
 	// 333 February 6 at 7ʰ42ᵐ TD
 }
 
+func ExampleTidalCorrection() {
+	// ΔT for the year -500 adjusted for ṅ = -25.858″/cy², the value of
+	// the lunar theory of chapter 47.
+	ΔT := deltat.PolyBefore948(-500)
+	c := deltat.TidalCorrection(-500, -25.858)
+	fmt.Printf("ΔT:         %+.0f seconds\n", ΔT)
+	fmt.Printf("correction: %+.1f seconds\n", c)
+	fmt.Printf("adjusted:   %+.0f seconds\n", ΔT+c)
+	// Output:
+	// ΔT:         +17314 seconds
+	// correction: -77.9 seconds
+	// adjusted:   +17237 seconds
+}
+
 // Table 10.A p. 79 provides a way to test these polynomials
 func TestPoly1800to1997(t *testing.T) {
 	for _, tp := range []struct {
@@ -101,3 +168,55 @@ func TestPoly1900to1997(t *testing.T) {
 		}
 	}
 }
+
+func ExampleSpline10A() {
+	// Example 10.a, p. 78.
+	jd := julian.CalendarGregorianToJD(1977, 2, 18)
+	fmt.Printf("%+.1f seconds\n", deltat.Spline10A(jd))
+	fmt.Printf("%+.2f seconds per year\n", deltat.Spline10ARate(jd))
+	// Output:
+	// +47.6 seconds
+	// +1.00 seconds per year
+}
+
+func TestSpline10A(t *testing.T) {
+	// spline passes through table values
+	for _, tp := range []struct {
+		year int
+		ΔT   unit.Time
+	}{
+		{1620, 121},
+		{1800, 13.1},
+		{1900, -2.8},
+		{1996, 61.6},
+		{2010, 66.1},
+	} {
+		jd := julian.CalendarGregorianToJD(tp.year, 1, 0)
+		if ΔT := deltat.Spline10A(jd); math.Abs((ΔT - tp.ΔT).Sec()) > 1e-9 {
+			t.Errorf("%#v, got %.10f", tp, ΔT)
+		}
+	}
+	// ΔT and its rate are continuous across table rows, where the rate
+	// of Interp10A is not.
+	for y := 1622; y < 2010; y += 2 {
+		jd := julian.CalendarGregorianToJD(y, 1, 0)
+		const ε = 1e-3
+		if d := deltat.Spline10A(jd+ε) - deltat.Spline10A(jd-ε); math.Abs(d.Sec()) > 1e-4 {
+			t.Errorf("year %d: ΔT jumps %.6f s", y, d.Sec())
+		}
+		if d := deltat.Spline10ARate(jd+ε) - deltat.Spline10ARate(jd-ε); math.Abs(d) > 1e-4 {
+			t.Errorf("year %d: rate jumps %.6f s/yr", y, d)
+		}
+	}
+	// rate agrees with a numerical derivative
+	for y := 1621; y < 2010; y += 2 {
+		jd := julian.CalendarGregorianToJD(y, 1, 1)
+		d1 := deltat.Spline10A(jd - 2)
+		d2 := deltat.Spline10A(jd + 2)
+		rate := deltat.Spline10ARate(jd)
+		if math.Abs((d2-d1).Sec()/4*365-rate) > .01 {
+			t.Errorf("year %d: rate %.4f, numerical %.4f",
+				y, rate, (d2-d1).Sec()/4*365)
+		}
+	}
+}
@@ -87,6 +87,14 @@
 // as helper subroutines or IO subroutines.  The functions do not offer
 // additional astronomy algorithms beyond those provided by Meeus.
 //
+// Package "sky" also does not correspond to a chapter.  It combines functions
+// of several chapter packages to compute the positions and appearance of the
+// Sun, Moon, and planets for an observer.  Package "crescent" similarly
+// combines them to predict visibility of the young lunar crescent, and
+// package "heliacal" the heliacal rising and setting of stars and planets.
+// Package "almanac" produces daily tables of rising and setting, twilight,
+// and other phenomena of the Sun and Moon.
+//
 // Identifiers
 //
 // To more closely follow the book's use of Greek letters and other symbols,
@@ -4,6 +4,14 @@
 // Easter: Chapter 8, Date of Easter
 package easter
 
+import (
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/deltat"
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/moonphase"
+	"github.com/soniakeys/meeus/v3/solstice"
+)
+
 // Gregorian returns month and day of Easter in the Gregorian calendar.
 func Gregorian(y int) (m, d int) {
 	a := y % 19
@@ -31,3 +39,78 @@ func Julian(y int) (m, d int) {
 	f, g := f/31, f%31
 	return f, g + 1
 }
+
+// GregorianPaschalFullMoon returns month and day of the ecclesiastical
+// Paschal full moon in the Gregorian calendar.
+//
+// Easter, as returned by Gregorian, is the first Sunday after this date.
+// The computation shares quantities a through h with Gregorian, with the
+// correction for epacts 24 and 25 of the Gregorian reform.
+func GregorianPaschalFullMoon(y int) (m, d int) {
+	a := y % 19
+	b := y / 100
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - b/4 - g + 15) % 30
+	n := 21 + h - (a+11*h)/319 // day of March
+	if n > 31 {
+		return 4, n - 31
+	}
+	return 3, n
+}
+
+// JulianPaschalFullMoon returns month and day of the ecclesiastical
+// Paschal full moon in the Julian calendar.
+//
+// Easter, as returned by Julian, is the first Sunday after this date.
+func JulianPaschalFullMoon(y int) (m, d int) {
+	n := 21 + (19*(y%19)+15)%30 // day of March
+	if n > 31 {
+		return 4, n - 31
+	}
+	return 3, n
+}
+
+// jerusalem is the longitude used by Astronomical for dates of the
+// equinox and full moon, as a fraction of a day east of Greenwich.
+const jerusalem = 35.2 / 360
+
+// Astronomical returns month and day of Easter in the Gregorian calendar
+// computed from the astronomical March equinox and full moon.
+//
+// The rule is that proposed by the World Council of Churches in 1997:
+// Easter is the Sunday following the first full moon after the March
+// equinox, with dates reckoned at the meridian of Jerusalem.  The equinox is
+// computed with solstice.March and the full moon with moonphase.Full.
+//
+// For years before 1583 the date is in the proleptic Gregorian calendar,
+// for comparison with Gregorian.
+//
+// Results equinox and fullMoon are the jdes of the equinox and full moon.
+func Astronomical(y int) (m, d int, equinox, fullMoon float64) {
+	equinox = solstice.March(y)
+	fullMoon = moonphase.Full(base.JDEToJulianYear(equinox))
+	if fullMoon < equinox {
+		fullMoon = moonphase.Full(base.JDEToJulianYear(equinox + 29.5))
+	}
+	// jd of 0h of the date of the full moon at Jerusalem
+	jd := julian.StartOfDayUT(fullMoon - deltat.Interp10A(fullMoon).Day() +
+		jerusalem)
+	jd += float64(7 - julian.DayOfWeek(jd))
+	_, m, dd := julian.JDToCalendarGregorian(jd)
+	return m, int(dd), equinox, fullMoon
+}
+
+// Divergent returns years from y1 through y2 for which the date of Easter
+// of Gregorian differs from that of Astronomical.
+func Divergent(y1, y2 int) []int {
+	var ys []int
+	for y := y1; y <= y2; y++ {
+		m1, d1 := Gregorian(y)
+		m2, d2, _, _ := Astronomical(y)
+		if m1 != m2 || d1 != d2 {
+			ys = append(ys, y)
+		}
+	}
+	return ys
+}
@@ -5,9 +5,11 @@ package easter_test
 
 import (
 	"fmt"
+	"testing"
 	"time"
 
 	"github.com/soniakeys/meeus/v3/easter"
+	"github.com/soniakeys/meeus/v3/julian"
 )
 
 func ExampleGregorian() {
@@ -33,3 +35,60 @@ func ExampleJulian() {
 	// Output:
 	// 1243 : April 12
 }
+
+func ExampleGregorianPaschalFullMoon() {
+	for _, y := range []int{1991, 2019} {
+		m, d := easter.GregorianPaschalFullMoon(y)
+		fmt.Println(y, ":", time.Month(m), d)
+	}
+	// Output:
+	// 1991 : March 30
+	// 2019 : April 18
+}
+
+func ExampleAstronomical() {
+	// In 2019 the full moon closely followed the equinox.  It was before
+	// the ecclesiastical equinox of March 21, so the Paschal full moon and
+	// Easter were a month later.
+	m, d, _, _ := easter.Astronomical(2019)
+	fmt.Println("Astronomical:", time.Month(m), d)
+	m, d = easter.Gregorian(2019)
+	fmt.Println("Gregorian:   ", time.Month(m), d)
+	fmt.Println(easter.Divergent(2000, 2050))
+	// Output:
+	// Astronomical: March 24
+	// Gregorian:    April 21
+	// [2019 2038 2045 2049]
+}
+
+func TestPaschalFullMoon(t *testing.T) {
+	for y := 1583; y < 4100; y++ {
+		m, d := easter.Gregorian(y)
+		e := julian.CalendarGregorianToJD(y, m, float64(d))
+		m, d = easter.GregorianPaschalFullMoon(y)
+		p := julian.CalendarGregorianToJD(y, m, float64(d))
+		if julian.DayOfWeek(e) != 0 || e-p < 1 || e-p > 7 ||
+			p < julian.CalendarGregorianToJD(y, 3, 21) {
+			t.Fatal("Gregorian", y, m, d)
+		}
+		m, d = easter.Julian(y)
+		e = julian.CalendarJulianToJD(y, m, float64(d))
+		m, d = easter.JulianPaschalFullMoon(y)
+		p = julian.CalendarJulianToJD(y, m, float64(d))
+		if julian.DayOfWeek(e) != 0 || e-p < 1 || e-p > 7 {
+			t.Fatal("Julian", y, m, d)
+		}
+	}
+}
+
+func TestAstronomicalProleptic(t *testing.T) {
+	// Before 1583 the date is proleptic Gregorian, and so a Sunday when
+	// converted as a Gregorian date.
+	for y := 1500; y < 1583; y++ {
+		m, d, _, fullMoon := easter.Astronomical(y)
+		e := julian.CalendarGregorianToJD(y, m, float64(d))
+		if julian.DayOfWeek(e) != 0 || e < fullMoon-1 || e > fullMoon+8 {
+			t.Fatal(y, m, d)
+		}
+	}
+}
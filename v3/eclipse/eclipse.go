@@ -5,10 +5,19 @@
 package eclipse
 
 import (
+	"errors"
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/iterate"
+	"github.com/soniakeys/meeus/v3/moon"
 	"github.com/soniakeys/meeus/v3/moonphase"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/nutation"
+	"github.com/soniakeys/meeus/v3/sidereal"
+	"github.com/soniakeys/meeus/v3/solar"
 	"github.com/soniakeys/unit"
 )
 
@@ -16,17 +25,17 @@ func g(k, jm, c1, c2 float64) (eclipse bool, jmax, γ, u, Mʹ float64) {
 	const ck = 1 / 1236.85
 	const p = math.Pi / 180
 	T := k * ck
-	F := base.Horner(T, 160.7108*p, 390.67050284*p/ck,
-		-.0016118*p, -.00000227*p, .000000011*p)
+	// arguments at the mean phase jm; equivalent to those of (49.4)
+	// through (49.6) p. 350.
+	_, aM, aMʹ, aF := moonposition.Arguments(jm)
+	F := aF.Rad()
 	if math.Abs(math.Sin(F)) > .36 {
 		return // no eclipse
 	}
 	eclipse = true
 	E := base.Horner(T, 1, -.002516, -.0000074)
-	M := base.Horner(T, 2.5534*p, 29.1053567*p/ck,
-		-.0000014*p, -.00000011*p)
-	Mʹ = base.Horner(T, 201.5643*p, 385.81693528*p/ck,
-		.0107582*p, .00001238*p, -.000000058*p)
+	M := aM.Rad()
+	Mʹ = aMʹ.Rad()
 	Ω := base.Horner(T, 124.7746*p, -1.56375588*p/ck,
 		.0020672*p, .00000215*p)
 	sΩ := math.Sin(Ω)
@@ -117,12 +126,35 @@ func snap(y, q float64) float64 {
 //
 // γ, u, and p are in units of equatorial Earth radii.
 func Solar(year float64) (eclipseType int, central bool, jmax, γ, u, p, mag float64) {
+	eclipseType, central, jmax, γ, u, p, mag, _ = solarEclipse(year)
+	return
+}
+
+// solarEclipse implements Solar, additionally returning the Moon's mean anomaly Mʹ.
+func solarEclipse(year float64) (eclipseType int, central bool, jmax, γ, u, p, mag, Mʹ float64) {
 	var e bool
-	e, jmax, γ, u, _ = g(snap(year, 0), moonphase.MeanNew(year), -.4075, .1721)
+	e, jmax, γ, u, Mʹ = g(snap(year, 0), moonphase.MeanNew(year), -.4075, .1721)
 	p = u + .5461
 	if !e {
 		return // no eclipse
 	}
+	eclipseType, central, mag = SolarType(γ, u)
+	return
+}
+
+// SolarType classifies a solar eclipse from γ and u as returned by Solar.
+//
+// Results are as described for Solar:  eclipseType is None, Partial,
+// Annular, AnnularTotal, or Total; central is true if the axis of the
+// shadow cone touches the Earth; mag is the magnitude of a partial eclipse.
+//
+// The thresholds are those of chapter 54, p. 381.  With |γ| > 1.5433 + u
+// there is no eclipse.  With |γ| < 0.9972 the eclipse is central, and is
+// total if u < 0, annular if u > 0.0047, and otherwise annular-total or
+// annular depending on ω = 0.00464 √(1-γ²).  A non-central eclipse with
+// 0.9972 < |γ| < 0.9972 + |u| is total or annular but not central, and is
+// reported as Total.  Other eclipses are partial.
+func SolarType(γ, u float64) (eclipseType int, central bool, mag float64) {
 	aγ := math.Abs(γ)
 	if aγ > 1.5433+u {
 		return // no eclipse
@@ -155,6 +187,66 @@ func Solar(year float64) (eclipseType int, central bool, jmax, γ, u, p, mag flo
 	return
 }
 
+// SolarContacts holds times of contacts of the Moon's shadow cones with the
+// Earth during a solar eclipse, as jdes.
+//
+// P1 and P4 are the first and last external contacts of the penumbral cone,
+// the beginning and end of the partial phase anywhere on the Earth.  P2 and
+// P3 are the first and last internal contacts of the penumbral cone.  U1
+// through U4 are the corresponding contacts of the umbral (or antumbral)
+// cone.  Max is the time of maximum eclipse, jmax as returned from Solar.
+//
+// Contacts that do not occur are zero.
+type SolarContacts struct {
+	P1, P2, U1, U2, Max, U3, U4, P3, P4 float64
+}
+
+// SolarTimes computes times of contacts of the Moon's shadow cones with the
+// Earth for the solar eclipse nearest a date.
+//
+// Argument year is a decimal year specifying a date.
+//
+// eclipseType is as returned from Solar.  If None, c is the zero value.
+//
+// The shadow axis is taken to move in a straight line past the center of
+// the Earth, with closest approach γ at jmax, at the speed given by Meeus
+// for the Moon relative to the Earth's shadow, p. 381.  Contacts are when the
+// distance of the axis from the center of the Earth equals the sum or
+// difference of the radius of the Earth and the radius of the cone.
+func SolarTimes(year float64) (eclipseType int, c SolarContacts) {
+	var jmax, γ, u, p, Mʹ float64
+	eclipseType, _, jmax, γ, u, p, _, Mʹ = solarEclipse(year)
+	if eclipseType == None {
+		return
+	}
+	c.Max = jmax
+	n := (.5458 + .04*math.Cos(Mʹ)) * 24 // Earth radii per day
+	γ2 := γ * γ
+	// sd returns semiduration in days for distance d, or false if the
+	// axis does not come within d of the center of the Earth.
+	sd := func(d float64) (float64, bool) {
+		if d*d <= γ2 {
+			return 0, false
+		}
+		return math.Sqrt(d*d-γ2) / n, true
+	}
+	const R = .9972 // radius of the Earth as used by Solar
+	au := math.Abs(u)
+	if t, ok := sd(R + p); ok {
+		c.P1, c.P4 = jmax-t, jmax+t
+	}
+	if t, ok := sd(R - p); ok {
+		c.P2, c.P3 = jmax-t, jmax+t
+	}
+	if t, ok := sd(R + au); ok {
+		c.U1, c.U4 = jmax-t, jmax+t
+	}
+	if t, ok := sd(R - au); ok {
+		c.U2, c.U3 = jmax-t, jmax+t
+	}
+	return
+}
+
 // Lunar computes quantities related to lunar eclipses.
 //
 // Argument year is a decimal year specifying a date.
@@ -187,19 +279,8 @@ func Lunar(year float64) (eclipseType int, jmax, γ, ρ, σ, mag float64, sdTota
 	}
 	ρ = 1.2848 + u
 	σ = .7403 - u
-	aγ := math.Abs(γ)
-	mag = (1.0128 - u - aγ) / .545 // (54.3) p. 382
-	switch {
-	case mag > 1:
-		eclipseType = Total
-	case mag > 0:
-		eclipseType = Umbral
-	default:
-		mag = (1.5573 + u - aγ) / .545 // (54.4) p. 382
-		if mag < 0 {
-			return // no eclipse
-		}
-		eclipseType = Penumbral
+	if eclipseType, mag = LunarType(γ, u); eclipseType == None {
+		return // no eclipse
 	}
 	p := 1.0128 - u
 	t := .4678 - u
@@ -218,3 +299,401 @@ func Lunar(year float64) (eclipseType int, jmax, γ, ρ, σ, mag float64, sdTota
 	}
 	return
 }
+
+// LunarType classifies a lunar eclipse from γ and u.
+//
+// Argument γ is as returned by Lunar.  Argument u is the quantity u of
+// p. 380.  Lunar does not return it but it is ρ - 1.2848 for the ρ returned
+// by Lunar.
+//
+// Result eclipseType is None, Penumbral, Umbral, or Total.  Mag is the
+// umbral magnitude (54.3) for Umbral and Total eclipses and the penumbral
+// magnitude (54.4) for Penumbral eclipses.
+func LunarType(γ, u float64) (eclipseType int, mag float64) {
+	aγ := math.Abs(γ)
+	mag = (1.0128 - u - aγ) / .545 // (54.3) p. 382
+	switch {
+	case mag > 1:
+		return Total, mag
+	case mag > 0:
+		return Umbral, mag
+	}
+	mag = (1.5573 + u - aγ) / .545 // (54.4) p. 382
+	if mag < 0 {
+		return None, 0 // no eclipse
+	}
+	return Penumbral, mag
+}
+
+// lunation returns the integer lunation number, counted in the convention
+// of chapter 49 with 0 the New Moon of 2000 January 6, for a jde near
+// a phase q.  q is 0 for New Moon, .5 for Full Moon.
+func lunation(jde, q float64) int {
+	// inverse of (49.1) p. 349, ignoring the small higher order terms.
+	return int(math.Floor((jde-2451550.09766)/29.530588861 - q + .5))
+}
+
+// lunationYear returns a decimal year that snaps to lunation k at phase q.
+func lunationYear(k int, q float64) float64 {
+	return 2000 + (float64(k)+q)/12.3685
+}
+
+// Lengths of the saros and inex in lunations.
+const (
+	sarosLen = 223
+	inexLen  = 358
+)
+
+// Constants c of the relation k = c + 358*saros + 223*inex, each computed
+// from a reference eclipse with catalog saros and inex numbers:  the solar
+// eclipse of 2009 July 22, lunation 118, saros 136, inex 64, and the lunar
+// eclipse of 2019 January 21, lunation 235 (of the preceding New Moon),
+// saros 134, inex 68.
+const (
+	solarC = 118 - inexLen*136 - sarosLen*64 // -62842
+	lunarC = 235 - inexLen*134 - sarosLen*68 // -62901
+)
+
+// Offsets for function series, placing the estimate (k-c)/358 - off at the
+// middle of the range of series in progress at the reference eclipses,
+// about saros 136 for solar and 130 for lunar eclipses.
+const (
+	solarOff = (118-solarC)/inexLen - 136 // 39
+	lunarOff = (235-lunarC)/inexLen - 130 // 46
+)
+
+// series identifies the saros and inex series of an eclipse at lunation k.
+//
+// Lunation numbers of eclipses satisfy k = c + 358*s + 223*i where s and i
+// are the saros and inex numbers.  The relation alone determines s only
+// modulo 223 so s is taken as the value closest to an estimate of the middle
+// of the range of saros series in progress at k.  The estimate increases by
+// one every 358 lunations, one inex; argument off adjusts it.  Active series
+// span roughly 45 numbers so the choice is unambiguous.
+func series(k, c, off int) (s, i int) {
+	m := k - c
+	// 38 is the inverse of 358 modulo 223.
+	s = 38 * m
+	s = base.PModInt(s, sarosLen)
+	sc := base.FloorDiv(m, inexLen) - off
+	s += base.FloorDiv(sc-s+sarosLen/2, sarosLen) * sarosLen
+	i = (m - inexLen*s) / sarosLen
+	return
+}
+
+// SolarSaros returns the saros and inex series of a solar eclipse.
+//
+// Argument jmax is the time of maximum eclipse as returned from Solar.
+//
+// Saros numbers follow the numbering commonly used in eclipse catalogs, for
+// example the eclipse of 2009 July 22 is number 136.  Inex numbers are
+// defined by the relation k = 358*saros + 223*inex - 62842 where k is the
+// lunation number of chapter 49.
+//
+// Result n is the position of the eclipse within its saros series, 1 for the
+// first eclipse of the series.  It is found by stepping back one saros at
+// a time until Solar no longer finds an eclipse.
+func SolarSaros(jmax float64) (saros, inex, n int) {
+	k := lunation(jmax, 0)
+	saros, inex = series(k, solarC, solarOff)
+	for n = 1; ; n++ {
+		k -= sarosLen
+		if t, _, _, _, _, _, _ := Solar(lunationYear(k, 0)); t == None {
+			return
+		}
+	}
+}
+
+// LunarSaros returns the saros and inex series of a lunar eclipse.
+//
+// Argument jmax is the time of maximum eclipse as returned from Lunar.
+//
+// Saros numbers follow the numbering commonly used in eclipse catalogs, for
+// example the eclipse of 2019 January 21 is number 134.  Inex numbers are
+// defined by the relation k = 358*saros + 223*inex - 62901 where k is the
+// lunation number of chapter 49 of the preceding New Moon.
+//
+// Result n is the position of the eclipse within its saros series, 1 for the
+// first eclipse of the series.  It is found by stepping back one saros at
+// a time until the Moon no longer enters the penumbra by the criterion of
+// Lunar, |γ| < 1.5573 + u.
+//
+// Member numbers are approximate.  The first members of a series are faint
+// penumbral eclipses for which |γ| is near the limit, and the approximate γ
+// of Lunar may miss them.  For the eclipses of the package tests n is
+// smaller than the number of the Five Millennium Canon of Espenak and Meeus
+// by up to 3.
+func LunarSaros(jmax float64) (saros, inex, n int) {
+	k := lunation(jmax, .5)
+	saros, inex = series(k, lunarC, lunarOff)
+	for n = 1; ; n++ {
+		k -= sarosLen
+		y := lunationYear(k, .5)
+		e, _, γ, u, _ := g(snap(y, .5), moonphase.MeanFull(y), -.4065, .1727)
+		if !e || math.Abs(γ) > 1.5573+u {
+			return
+		}
+	}
+}
+
+// Ratios of the radii of the Moon for the penumbral and umbral shadows and of
+// the Sun to the equatorial radius of the Earth.
+const (
+	k1   = .2725076
+	k2   = .272281
+	kSun = 109.1223
+)
+
+// Shadow returns the radii of the penumbral and umbral cones of the
+// Earth's shadow in a plane at distance d from the center of the Earth.
+//
+// Arguments d and R are the distances of the plane and of the Sun from the
+// center of the Earth, both in units of the equatorial radius of the Earth.
+// The radius of the Earth is enlarged by 1/85 to account for the atmosphere,
+// following Danjon.
+//
+// Results ρ and σ are radii of the penumbra and umbra in units of the
+// equatorial radius of the Earth.  At the distance of the Moon they
+// correspond to the values ρ and σ returned by Lunar.
+func Shadow(d, R float64) (ρ, σ float64) {
+	const kE = 1 + 1./85
+	f1 := math.Asin((kSun + kE) / R)
+	f2 := math.Asin((kSun - kE) / R)
+	ρ = d*math.Tan(f1) + kE/math.Cos(f1)
+	σ = kE/math.Cos(f2) - d*math.Tan(f2)
+	return
+}
+
+// LunarShadow returns the radii of the penumbral and umbral cones of the
+// Earth's shadow at the distance of the Moon at time jde.
+//
+// Distances of the Sun and Moon are those of package solar and package
+// moonposition.  Results are as for Shadow.
+func LunarShadow(jde float64) (ρ, σ float64) {
+	_, _, Δ := moonposition.Position(jde)
+	R := solar.Radius(base.J2000Century(jde)) * base.AU
+	return Shadow(Δ/globe.Earth76.Er, R/globe.Earth76.Er)
+}
+
+// ErrorNoUmbralContact is returned by CraterTimes when a site on the Moon
+// does not enter the umbra.
+var ErrorNoUmbralContact = errors.New("site does not enter umbra")
+
+// CraterTimes computes times when the edge of the umbra crosses a site on
+// the Moon during a lunar eclipse, as observed in crater timings.
+//
+// Argument jmax is the time of maximum eclipse, as returned by Lunar.
+// Arguments η, θ are selenographic longitude and latitude of the site, as
+// used in package moon.
+//
+// Results are the times of immersion into and emersion from the umbra, as
+// jdes.  The umbra is that of Shadow, with the radius of the Earth enlarged
+// by 1/85 for the atmosphere.  Positions of the Moon and Sun are those of
+// packages moonposition and solar.
+//
+// ErrorNoUmbralContact is returned if the site does not enter the umbra in
+// the six hours centered on jmax.
+func CraterTimes(jmax float64, η, θ unit.Angle) (immersion, emersion float64, err error) {
+	er := globe.Earth76.Er
+	// g returns distance of the site from the shadow axis less the radius
+	// of the umbra, in Earth radii.
+	g := func(jde float64) float64 {
+		λ, β, Δ := moonposition.Position(jde)
+		T := base.J2000Century(jde)
+		s, _ := solar.True(T)
+		R := solar.Radius(T)
+		// antisolar direction, Sun corrected for light time
+		sa, ca := (s + math.Pi - unit.AngleFromSec(20.4898).Div(R)).Sincos()
+		a := [3]float64{ca, sa, 0}
+		// site, geocentric, in Earth radii
+		sλ, cλ := λ.Sincos()
+		sβ, cβ := β.Sincos()
+		λn, βn := moon.SelenographicToEcliptic(jde, η, θ)
+		sλn, cλn := λn.Sincos()
+		sβn, cβn := βn.Sincos()
+		d := Δ / er
+		c := [3]float64{
+			d*cβ*cλ + k1*cβn*cλn,
+			d*cβ*sλ + k1*cβn*sλn,
+			d*sβ + k1*sβn,
+		}
+		z := c[0]*a[0] + c[1]*a[1] + c[2]*a[2]
+		x, y, w := c[0]-z*a[0], c[1]-z*a[1], c[2]-z*a[2]
+		_, σ := Shadow(z, R*base.AU/er)
+		return math.Sqrt(x*x+y*y+w*w) - σ
+	}
+	const step = 2. / 1440
+	j1 := jmax - .125
+	g1 := g(j1)
+	immersion, emersion = math.NaN(), math.NaN()
+	for j2 := j1 + step; j2 <= jmax+.125; j2 += step {
+		g2 := g(j2)
+		switch {
+		case g1 > 0 && g2 <= 0 && math.IsNaN(immersion):
+			immersion = iterate.BinaryRoot(g, j1, j2)
+		case g1 <= 0 && g2 > 0 && math.IsNaN(emersion):
+			emersion = iterate.BinaryRoot(g, j1, j2)
+		}
+		j1, g1 = j2, g2
+	}
+	if math.IsNaN(immersion) || math.IsNaN(emersion) {
+		return 0, 0, ErrorNoUmbralContact
+	}
+	return
+}
+
+// Besselian holds Besselian elements of a solar eclipse at an instant.
+//
+// The fundamental plane passes through the center of the Earth perpendicular
+// to the axis of the Moon's shadow.  Lengths are in units of the equatorial
+// radius of the Earth, ellipsoid globe.Earth76.
+type Besselian struct {
+	X, Y   float64    // coordinates of the shadow axis in the fundamental plane
+	D      unit.Angle // declination of the shadow axis
+	Mu     unit.Angle // Greenwich hour angle of the shadow axis
+	L1, L2 float64    // radii of the penumbral and umbral cones in the plane
+	F1, F2 unit.Angle // half-angles of the penumbral and umbral cones
+}
+
+// NewBesselian computes Besselian elements at time jde.
+//
+// Positions of the Sun and Moon are those of package solar and package
+// moonposition.  Argument ΔT is used to compute the sidereal time for
+// element Mu.
+//
+// L2 is negative where the umbral cone is real, that is, where the eclipse
+// would be total.
+func NewBesselian(jde float64, ΔT unit.Time) *Besselian {
+	αs, δs := solar.ApparentEquatorial(jde)
+	rs := solar.Radius(base.J2000Century(jde)) * base.AU / globe.Earth76.Er
+	λ, β, Δ := moonposition.Position(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	sε, cε := (nutation.MeanObliquity(jde) + Δε).Sincos()
+	αm, δm := coord.EclToEq(λ+Δψ, β, sε, cε)
+	rm := Δ / globe.Earth76.Er
+	// vector from the Moon to the Sun
+	sαs, cαs := αs.Sincos()
+	sδs, cδs := δs.Sincos()
+	sαm, cαm := αm.Sincos()
+	sδm, cδm := δm.Sincos()
+	gx := rs*cδs*cαs - rm*cδm*cαm
+	gy := rs*cδs*sαs - rm*cδm*sαm
+	gz := rs*sδs - rm*sδm
+	g := math.Sqrt(gx*gx + gy*gy + gz*gz)
+	a := unit.RAFromRad(math.Atan2(gy, gx))
+	b := &Besselian{D: unit.Angle(math.Asin(gz / g))}
+	sd, cd := b.D.Sincos()
+	sH, cH := (αm - a).Sincos()
+	b.X = rm * cδm * sH
+	b.Y = rm * (sδm*cd - cδm*sd*cH)
+	z := rm * (sδm*sd + cδm*cd*cH)
+	b.F1 = unit.Angle(math.Asin((kSun + k1) / g))
+	b.F2 = unit.Angle(math.Asin((kSun - k2) / g))
+	b.L1 = z*b.F1.Tan() + k1/b.F1.Cos()
+	b.L2 = z*b.F2.Tan() - k2/b.F2.Cos()
+	st := sidereal.Apparent(jde - ΔT.Day())
+	b.Mu = (st.Angle() - a.Angle()).Mod1()
+	return b
+}
+
+// CentralPoint is a point on the central line of a solar eclipse.
+type CentralPoint struct {
+	JDE      float64
+	Coord    globe.Coord // geographic coordinates of the shadow axis
+	Width    float64     // width of the path of totality or annularity, Km
+	Duration unit.Time   // duration of the central phase on the central line
+}
+
+// CentralLine walks the shadow axis of a central solar eclipse across
+// the Earth.
+//
+// Argument jmax is the time of maximum eclipse as returned from Solar,
+// step is the sampling interval in days.  ΔT is as for NewBesselian.
+//
+// Points are returned in time order at times jmax + n*step for all integers
+// n where the shadow axis meets the ellipsoid.  No points are returned for
+// an eclipse that is not central.
+//
+// Width is the width of the path perpendicular to the central line, computed
+// with a spherical approximation for the local vertical.
+func CentralLine(jmax, step float64, ΔT unit.Time) []CentralPoint {
+	var before, after []CentralPoint
+	for j := jmax; ; j -= step {
+		p, ok := central(j, ΔT)
+		if !ok {
+			break
+		}
+		before = append(before, p)
+	}
+	for j := jmax + step; ; j += step {
+		p, ok := central(j, ΔT)
+		if !ok {
+			break
+		}
+		after = append(after, p)
+	}
+	for i, j := 0, len(before)-1; i < j; i, j = i+1, j-1 {
+		before[i], before[j] = before[j], before[i]
+	}
+	return append(before, after...)
+}
+
+// central computes a point on the central line at time jde.
+//
+// Result ok is false if the shadow axis misses the Earth.
+func central(jde float64, ΔT unit.Time) (p CentralPoint, ok bool) {
+	b := NewBesselian(jde, ΔT)
+	// point of the ellipsoid on the shadow axis, Explanatory Supplement
+	// to the Astronomical Almanac, 1992, section 8.3.
+	e2 := globe.Earth76.Eccentricity()
+	e2 *= e2
+	sd, cd := b.D.Sincos()
+	ρ1 := math.Sqrt(1 - e2*cd*cd)
+	sd1 := sd / ρ1
+	cd1 := math.Sqrt(1-e2) * cd / ρ1
+	y1 := b.Y / ρ1
+	B := 1 - b.X*b.X - y1*y1
+	if B < 0 {
+		return
+	}
+	ζ1 := math.Sqrt(B)
+	φ1 := math.Asin(y1*cd1 + ζ1*sd1)
+	θ := unit.Angle(math.Atan2(b.X, ζ1*cd1-y1*sd1))
+	p.JDE = jde
+	p.Coord.Lat = unit.Angle(math.Atan(math.Tan(φ1) / math.Sqrt(1-e2)))
+	p.Coord.Lon = (b.Mu - θ).Mod1()
+	if p.Coord.Lon > math.Pi {
+		p.Coord.Lon -= 2 * math.Pi
+	}
+	// observer coordinates in the fundamental plane
+	ρs, ρc := globe.Earth76.ParallaxConstants(p.Coord.Lat, 0)
+	sθ, cθ := θ.Sincos()
+	ξ := ρc * sθ
+	η := ρs*cd - ρc*sd*cθ
+	ζ := ρs*sd + ρc*cd*cθ
+	L2 := b.L2 - ζ*b.F2.Tan()
+	// velocity of the shadow relative to the observer, by differencing
+	// elements over an interval of h days.
+	const h = .005
+	b0 := NewBesselian(jde-h, ΔT)
+	b1 := NewBesselian(jde+h, ΔT)
+	dx := (b1.X - b0.X) / (2 * h)
+	dy := (b1.Y - b0.Y) / (2 * h)
+	dd := (b1.D - b0.D).Rad() / (2 * h)
+	dμ := math.Remainder((b1.Mu-b0.Mu).Rad(), 2*math.Pi) / (2 * h)
+	vx := dx - dμ*(ζ*cd-η*sd)
+	vy := dy - dμ*ξ*sd + dd*ζ
+	v := math.Hypot(vx, vy)
+	p.Duration = unit.TimeFromDay(2 * math.Abs(L2) / v)
+	// ground direction of the track, and ground direction perpendicular
+	// to it, for the width.
+	tx, ty := vx, vy
+	tz := -(ξ*vx + η*vy) / ζ
+	wx := η*tz - ζ*ty
+	wy := ζ*tx - ξ*tz
+	wz := ξ*ty - η*tx
+	w := math.Sqrt(wx*wx + wy*wy + wz*wz)
+	p.Width = 2 * math.Abs(L2) * globe.Earth76.Er * w /
+		math.Abs(-wx*vy+wy*vx) * v
+	return p, true
+}
@@ -5,8 +5,15 @@ package eclipse_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
+	"time"
 
+	"github.com/soniakeys/meeus/v3/deltat"
 	"github.com/soniakeys/meeus/v3/eclipse"
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/sexagesimal"
+	"github.com/soniakeys/unit"
 )
 
 func ExampleSolar_1993() {
@@ -90,6 +97,32 @@ func ExampleSolar_2009() {
 	// Penumbral radius:              +0.5304
 }
 
+func ExampleSolarTimes() {
+	// The eclipse of example 54.b, 2009 July 22.
+	_, c := eclipse.SolarTimes(2009.56)
+	for _, t := range []struct {
+		name string
+		jde  float64
+	}{
+		{"P1", c.P1}, {"U1", c.U1}, {"U2", c.U2}, {"Max", c.Max},
+		{"U3", c.U3}, {"U4", c.U4}, {"P4", c.P4},
+	} {
+		_, m, d := julian.JDToCalendar(t.jde)
+		fmt.Printf("%-3s  %s %d %02.0s TD\n", t.name, time.Month(m), int(d),
+			sexa.FmtTime(unit.TimeFromDay(d-math.Floor(d))))
+	}
+	fmt.Printf("P2 to P3  %.2f days\n", c.P3-c.P2)
+	// Output:
+	// P1   July 22  00ʰ00ᵐ13ˢ TD
+	// U1   July 22  00ʰ53ᵐ03ˢ TD
+	// U2   July 22  00ʰ56ᵐ16ˢ TD
+	// Max  July 22  02ʰ36ᵐ37ˢ TD
+	// U3   July 22  04ʰ16ᵐ58ˢ TD
+	// U4   July 22  04ʰ20ᵐ11ˢ TD
+	// P4   July 22  05ʰ13ᵐ01ˢ TD
+	// P2 to P3  0.07 days
+}
+
 func ExampleLunar_1973() {
 	// Example 54.c, p. 385.
 	t, jm, γ, ρ, σ, mag, sdTotal, sdPartial, sdPenumbral :=
@@ -184,3 +217,176 @@ func ExampleLunar_1997() {
 	// Partial phase semiduration:     98 min
 	// Penumbral semiduration:        153 min
 }
+
+func ExampleLunarShadow() {
+	// Shadow radii at maximum of the eclipse of example 54.d, p. 386.
+	ρ, σ := eclipse.LunarShadow(2450708.2835)
+	fmt.Printf("Umbral radius, σ:     %+.4f\n", σ)
+	fmt.Printf("Penumbral radius, ρ:  %+.4f\n", ρ)
+	// Output:
+	// Umbral radius, σ:     +0.7551
+	// Penumbral radius, ρ:  +1.2732
+}
+
+func ExampleSolarSaros() {
+	// Eclipse of example 54.b, p. 385.
+	_, _, jmax, _, _, _, _ := eclipse.Solar(2009.56)
+	s, i, n := eclipse.SolarSaros(jmax)
+	fmt.Println("Saros:", s)
+	fmt.Println("Inex: ", i)
+	fmt.Println("Member", n, "of saros series")
+	// Output:
+	// Saros: 136
+	// Inex:  64
+	// Member 37 of saros series
+}
+
+func ExampleLunarSaros() {
+	// Eclipse of example 54.d, p. 386.  The Five Millennium Canon numbers it
+	// member 27; the three faint penumbral eclipses that begin the series
+	// are not found by Lunar.
+	_, jmax, _, _, _, _, _, _, _ := eclipse.Lunar(1997.7)
+	s, i, n := eclipse.LunarSaros(jmax)
+	fmt.Println("Saros:", s)
+	fmt.Println("Inex: ", i)
+	fmt.Println("Member", n, "of saros series")
+	// Output:
+	// Saros: 137
+	// Inex:  62
+	// Member 24 of saros series
+}
+
+// Saros series and member numbers from the Five Millennium Canon of
+// Espenak and Meeus, as published in the NASA eclipse catalogs.
+func TestSaros(t *testing.T) {
+	for _, c := range []struct {
+		y        int
+		m        time.Month
+		d        int
+		lunar    bool
+		saros, n int
+	}{
+		{2009, time.July, 22, false, 136, 37},
+		{2017, time.August, 21, false, 145, 22},
+		{2024, time.April, 8, false, 139, 30},
+		{2000, time.July, 16, true, 129, 37},
+		{2011, time.June, 15, true, 130, 34},
+		{2014, time.April, 15, true, 122, 56},
+		{2015, time.September, 28, true, 137, 28},
+		{2018, time.July, 27, true, 129, 38},
+		{2019, time.January, 21, true, 134, 27},
+		{2022, time.November, 8, true, 136, 20},
+	} {
+		year := float64(c.y) + (float64(c.m)-.5)/12
+		var s, n int
+		var jmax float64
+		if c.lunar {
+			_, jmax, _, _, _, _, _, _, _ = eclipse.Lunar(year)
+			s, _, n = eclipse.LunarSaros(jmax)
+		} else {
+			_, _, jmax, _, _, _, _ = eclipse.Solar(year)
+			s, _, n = eclipse.SolarSaros(jmax)
+		}
+		if y, m, d := julian.JDToCalendar(jmax); y != c.y || m != int(c.m) || int(d) != c.d {
+			t.Errorf("%d %s %d: found eclipse of %d %d %.1f", c.y, c.m, c.d, y, m, d)
+			continue
+		}
+		// Lunar member numbers may be smaller; see LunarSaros.
+		nMin := c.n
+		if c.lunar {
+			nMin -= 3
+		}
+		if s != c.saros || n > c.n || n < nMin {
+			t.Errorf("%d %s %d: saros %d member %d, want saros %d member %d",
+				c.y, c.m, c.d, s, n, c.saros, c.n)
+		}
+	}
+}
+
+func ExampleCentralLine() {
+	// Total eclipse of 2017 August 21.  Published values at greatest
+	// eclipse are 36°58′N, 87°40′W, duration 2m40s, path width 115 km.
+	_, _, jmax, _, _, _, _ := eclipse.Solar(2017.64)
+	path := eclipse.CentralLine(jmax, 1./48, unit.Time(69))
+	fmt.Println(len(path), "points")
+	for _, p := range path {
+		fmt.Printf("%.2f %7.2f %4.0f km %3.0fs\n",
+			p.Coord.Lat.Deg(), p.Coord.Lon.Deg(), p.Width, p.Duration.Sec())
+	}
+	// Output:
+	// 7 points
+	// 43.78  148.15   82 km  81s
+	// 44.46  117.51  103 km 129s
+	// 41.44  100.37  111 km 153s
+	// 36.96   87.65  114 km 160s
+	// 31.49   76.59  114 km 149s
+	// 24.92   64.70  107 km 123s
+	// 15.82   44.57   80 km  75s
+}
+
+func ExampleCraterTimes() {
+	// Total lunar eclipse of 2019 January 21.
+	_, jmax, _, _, _, _, _, _, _ := eclipse.Lunar(2019.05)
+	ΔT := deltat.Interp10A(jmax)
+	for _, c := range []struct {
+		name string
+		η, θ float64
+	}{
+		{"Grimaldi", -68.3, -5.2},
+		{"Tycho", -11.4, -43.3},
+		{"Copernicus", -20.1, 9.6},
+		{"Plato", -9.4, 51.6},
+		{"Proclus", 46.9, 16.1},
+	} {
+		im, em, err := eclipse.CraterTimes(jmax,
+			unit.AngleFromDeg(c.η), unit.AngleFromDeg(c.θ))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		_, fi := math.Modf(im + .5)
+		_, fe := math.Modf(em + .5)
+		fmt.Printf("%-10s  %02.0s  %02.0s UT\n", c.name,
+			sexa.FmtTime(unit.TimeFromDay(fi)-ΔT),
+			sexa.FmtTime(unit.TimeFromDay(fe)-ΔT))
+	}
+	// Output:
+	// Grimaldi     03ʰ36ᵐ18ˢ   05ʰ57ᵐ30ˢ UT
+	// Tycho        03ʰ55ᵐ33ˢ   06ʰ26ᵐ43ˢ UT
+	// Copernicus   03ʰ57ᵐ03ˢ   06ʰ08ᵐ31ˢ UT
+	// Plato        04ʰ17ᵐ26ˢ   05ʰ56ᵐ40ˢ UT
+	// Proclus      04ʰ30ᵐ36ˢ   06ʰ32ᵐ11ˢ UT
+}
+
+func ExampleSolarType() {
+	typ := []string{"none", "partial", "annular", "annular-total",
+		"penumbral", "umbral", "total"}
+	for _, c := range []struct{ γ, u float64 }{
+		{.2, -.01}, {.2, .02}, {.2, .002}, {1.01, -.02}, {1.2, .01}, {1.6, .01},
+	} {
+		t, central, mag := eclipse.SolarType(c.γ, c.u)
+		fmt.Printf("γ %+.2f  u %+.3f  %-13s  central %-5t  mag %.3f\n",
+			c.γ, c.u, typ[t], central, mag)
+	}
+	// Output:
+	// γ +0.20  u -0.010  total          central true   mag 0.000
+	// γ +0.20  u +0.020  annular        central true   mag 0.000
+	// γ +0.20  u +0.002  annular-total  central true   mag 0.000
+	// γ +1.01  u -0.020  total          central false  mag 0.000
+	// γ +1.20  u +0.010  partial        central false  mag 0.624
+	// γ +1.60  u +0.010  none           central false  mag 0.000
+}
+
+func ExampleLunarType() {
+	typ := []string{"none", "partial", "annular", "annular-total",
+		"penumbral", "umbral", "total"}
+	for _, γ := range []float64{.2, .8, 1.2, 1.6} {
+		t, mag := eclipse.LunarType(γ, .01)
+		fmt.Printf("γ %.1f  %-9s  mag %.3f\n", γ, typ[t], mag)
+	}
+	// Output:
+	// γ 0.2  total      mag 1.473
+	// γ 0.8  umbral     mag 0.372
+	// γ 1.2  penumbral  mag 0.674
+	// γ 1.6  none       mag 0.000
+}
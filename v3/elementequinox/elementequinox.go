@@ -7,6 +7,7 @@
 // See package precess for the method EclipticPrecessor.ReduceElements and
 // associated example.  The method is described in this chapter but located
 // in package precess so that it can be a method of EclipticPrecessor.
+// For reduction in steps of 100 years, see precess.ReduceElementsStepped.
 package elementequinox
 
 import (
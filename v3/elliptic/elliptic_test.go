@@ -4,11 +4,93 @@
 package elliptic_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"testing"
 
+	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/elliptic"
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/unit"
 )
 
+func ExampleNewElementsM() {
+	// Elements of example 33.b, p. 232, with mean anomaly given at
+	// the epoch 1990 October 6.0 TD.
+	epoch := julian.CalendarGregorianToJD(1990, 10, 6)
+	k := elliptic.NewElementsM(2.2091404, .8502196,
+		unit.AngleFromDeg(11.94524),
+		unit.AngleFromDeg(186.23352),
+		unit.AngleFromDeg(334.75006),
+		unit.AngleFromDeg(353.232633), epoch)
+	y, m, d := julian.JDToCalendar(k.TimeP)
+	fmt.Printf("T = %d %d %.5f\n", y, m, d)
+	fmt.Printf("M = %.5f\n", k.MeanAnomaly(epoch).Deg())
+	// Output:
+	// T = 1990 10 28.54502
+	// M = 353.23263
+}
+
+func ExampleElements_EccentricAnomaly() {
+	// Example 33.b, p. 232.
+	k := &elliptic.Elements{
+		TimeP: julian.CalendarGregorianToJD(1990, 10, 28.54502),
+		Axis:  2.2091404,
+		Ecc:   .8502196,
+		Inc:   unit.AngleFromDeg(11.94524),
+		Node:  unit.AngleFromDeg(334.75006),
+		ArgP:  unit.AngleFromDeg(186.23352),
+	}
+	E, d := k.EccentricAnomaly(julian.CalendarGregorianToJD(1990, 10, 6))
+	fmt.Printf("E = %.6f\n", E.Deg())
+	fmt.Println("Kepler2b:", d.Solver == elliptic.SolverKepler2b)
+	fmt.Println("residual < 1e-15:", d.Residual < 1e-15)
+	// Output:
+	// E = -34.026713
+	// Kepler2b: true
+	// residual < 1e-15: true
+}
+
+func TestEccentricAnomaly(t *testing.T) {
+	// Near-parabolic orbit just after perihelion, where Kepler2b does not
+	// converge in 15 iterations and the solution falls back to Kepler3.
+	k := &elliptic.Elements{Axis: 1, Ecc: .999}
+	_, d := k.EccentricAnomaly(1e-4 / base.K)
+	if d.Solver != elliptic.SolverKepler3 {
+		t.Fatal("expected fallback to Kepler3, got", d)
+	}
+	if d.Iterations != 15 || d.Residual > 1e-15 {
+		t.Fatal(d)
+	}
+	k.Ecc = .5
+	_, d = k.EccentricAnomaly(1 / base.K)
+	if d.Solver != elliptic.SolverKepler2b || d.Residual > 1e-15 {
+		t.Fatal(d)
+	}
+}
+
+func TestEccentricAnomalyLargeM(t *testing.T) {
+	// Mean anomaly of many revolutions.  The residual must be that of the
+	// reduced M, with either solver.
+	k := &elliptic.Elements{Axis: 1, Ecc: .5}
+	E, d := k.EccentricAnomaly(1234.5 / base.K)
+	if d.Solver != elliptic.SolverKepler2b || d.Residual > 1e-14 {
+		t.Fatal(d)
+	}
+	if math.Abs(E.Rad()) > math.Pi {
+		t.Fatal("E not reduced:", E)
+	}
+	k.Ecc = .999
+	E, d = k.EccentricAnomaly((196*2*math.Pi + 1e-4) / base.K)
+	if d.Solver != elliptic.SolverKepler3 || d.Residual > 1e-12 {
+		t.Fatal(d)
+	}
+	if math.Abs(E.Rad()) > math.Pi {
+		t.Fatal("E not reduced:", E)
+	}
+}
+
 func ExampleVelocity() {
 	// Example 33.c, p. 238
 	fmt.Printf("%.2f\n", elliptic.Velocity(17.9400782, 1))
@@ -57,3 +139,25 @@ func ExampleLength4() {
 	// Output:
 	// 77.07
 }
+
+func ExampleElements_MarshalJSON() {
+	// Elements of example 33.b, p. 232.
+	k := &elliptic.Elements{
+		TimeP: julian.CalendarGregorianToJD(1990, 10, 28.54502),
+		Axis:  2.2091404,
+		Ecc:   .8502196,
+		Inc:   unit.AngleFromDeg(11.94524),
+		Node:  unit.AngleFromDeg(334.75006),
+		ArgP:  unit.AngleFromDeg(186.23352),
+	}
+	b, err := json.Marshal(k)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(b))
+	fmt.Println(k.CSVRecord(base.Degree))
+	// Output:
+	// {"axis":2.2091404,"ecc":0.8502196,"inc":11.94524,"argp":186.23352,"node":334.75005999999996,"timep":2448193.04502}
+	// [2.2091404 0.8502196 11.94524 186.23352 334.75005999999996 2448193.04502]
+}
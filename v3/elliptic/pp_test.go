@@ -7,6 +7,7 @@ package elliptic_test
 
 import (
 	"fmt"
+	"testing"
 
 	"github.com/soniakeys/meeus/v3/elliptic"
 	"github.com/soniakeys/meeus/v3/julian"
@@ -35,6 +36,31 @@ func ExamplePosition() {
 	// δ = -18°53′16″.84
 }
 
+func ExamplePositionElongation() {
+	// Example 33.a, p. 225.  Elongation and phase angle computed from
+	// the distances of example 41.a, p. 284.
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	venus, err := pp.LoadPlanet(pp.Venus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	α, δ, ψ, i := elliptic.PositionElongation(venus, earth, 2448976.5)
+	fmt.Printf("α = %.3d\n", sexa.FmtRA(α))
+	fmt.Printf("δ = %.2d\n", sexa.FmtAngle(δ))
+	fmt.Printf("ψ = %.1f\n", ψ.Deg())
+	fmt.Printf("i = %.1f\n", i.Deg())
+	// Output:
+	// α = 21ʰ4ᵐ41ˢ.454
+	// δ = -18°53′16″.84
+	// ψ = 44.8
+	// i = 73.0
+}
+
 func ExampleElements_Position() {
 	// Example 33.b, p. 232.
 	earth, err := pp.LoadPlanet(pp.Earth)
@@ -60,3 +86,131 @@ func ExampleElements_Position() {
 	// δ = 19°9′31″
 	// ψ = 40.51
 }
+
+func ExampleElements_PositionDiag() {
+	// Example 33.b, p. 232.
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	k := &elliptic.Elements{
+		TimeP: julian.CalendarGregorianToJD(1990, 10, 28.54502),
+		Axis:  2.2091404,
+		Ecc:   .8502196,
+		Inc:   unit.AngleFromDeg(11.94524),
+		Node:  unit.AngleFromDeg(334.75006),
+		ArgP:  unit.AngleFromDeg(186.23352),
+	}
+	j := julian.CalendarGregorianToJD(1990, 10, 6)
+	α, δ, _, d := k.PositionDiag(j, earth)
+	fmt.Printf("α = %.1d\n", sexa.FmtRA(α))
+	fmt.Printf("δ = %.0d\n", sexa.FmtAngle(δ))
+	fmt.Println("Kepler2b:", d.Solver == elliptic.SolverKepler2b)
+	// Output:
+	// α = 10ʰ34ᵐ14ˢ.2
+	// δ = 19°9′31″
+	// Kepler2b: true
+}
+
+func ExampleGauss() {
+	// Observations of comet Encke computed from the elements of
+	// example 33.b, p. 232, at intervals of 10 days.
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	k := &elliptic.Elements{
+		TimeP: julian.CalendarGregorianToJD(1990, 10, 28.54502),
+		Axis:  2.2091404,
+		Ecc:   .8502196,
+		Inc:   unit.AngleFromDeg(11.94524),
+		Node:  unit.AngleFromDeg(334.75006),
+		ArgP:  unit.AngleFromDeg(186.23352),
+	}
+	α := make([]unit.RA, 3)
+	δ := make([]unit.Angle, 3)
+	j := make([]float64, 3)
+	for i := range j {
+		j[i] = julian.CalendarGregorianToJD(1990, 9, 26+10*float64(i))
+		α[i], δ[i], _ = k.Position(j[i], earth)
+	}
+	g, err := elliptic.Gauss(α, δ, j, earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, m, d := julian.JDToCalendar(g.TimeP)
+	fmt.Printf("T = %d %d %.4f\n", y, m, d)
+	fmt.Printf("a = %.5f\n", g.Axis)
+	fmt.Printf("e = %.6f\n", g.Ecc)
+	fmt.Printf("i = %.4f\n", g.Inc.Deg())
+	fmt.Printf("Ω = %.4f\n", g.Node.Deg())
+	fmt.Printf("ω = %.4f\n", g.ArgP.Deg())
+	// Output:
+	// T = 1990 10 28.5450
+	// a = 2.20914
+	// e = 0.850220
+	// i = 11.9452
+	// Ω = 334.7501
+	// ω = 186.2335
+}
+
+func ExampleEphemerisContext_Position() {
+	// Example 33.b, p. 232, computed through an EphemerisContext.
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	k := &elliptic.Elements{
+		TimeP: julian.CalendarGregorianToJD(1990, 10, 28.54502),
+		Axis:  2.2091404,
+		Ecc:   .8502196,
+		Inc:   unit.AngleFromDeg(11.94524),
+		Node:  unit.AngleFromDeg(334.75006),
+		ArgP:  unit.AngleFromDeg(186.23352),
+	}
+	c := elliptic.NewEphemerisContext(julian.CalendarGregorianToJD(1990, 10, 6), earth)
+	α, δ, ψ := c.Position(k)
+	fmt.Printf("α = %.1d\n", sexa.FmtRA(α))
+	fmt.Printf("δ = %.0d\n", sexa.FmtAngle(δ))
+	fmt.Printf("ψ = %.2f\n", ψ.Deg())
+	// Output:
+	// α = 10ʰ34ᵐ14ˢ.2
+	// δ = 19°9′31″
+	// ψ = 40.51
+}
+
+var benchElements = &elliptic.Elements{
+	TimeP: julian.CalendarGregorianToJD(1990, 10, 28.54502),
+	Axis:  2.2091404,
+	Ecc:   .8502196,
+	Inc:   unit.AngleFromDeg(11.94524),
+	Node:  unit.AngleFromDeg(334.75006),
+	ArgP:  unit.AngleFromDeg(186.23352),
+}
+
+func BenchmarkElementsPosition(b *testing.B) {
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		b.Skip(err)
+	}
+	j := julian.CalendarGregorianToJD(1990, 10, 6)
+	for i := 0; i < b.N; i++ {
+		benchElements.Position(j, earth)
+	}
+}
+
+func BenchmarkEphemerisContextPosition(b *testing.B) {
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		b.Skip(err)
+	}
+	c := elliptic.NewEphemerisContext(julian.CalendarGregorianToJD(1990, 10, 6), earth)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Position(benchElements)
+	}
+}
@@ -9,6 +9,9 @@ import (
 
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/coord"
+	"github.com/soniakeys/meeus/v3/interp"
+	"github.com/soniakeys/meeus/v3/iterate"
+	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/meeus/v3/nutation"
 	pp "github.com/soniakeys/meeus/v3/planetposition"
 	"github.com/soniakeys/meeus/v3/solar"
@@ -65,3 +68,61 @@ func ESmart(jde float64) unit.HourAngle {
 	return unit.HourAngle(y*s2L0 - 2*e*sM + 4*e*y*sM*c2L0 -
 		y*y*s2L0*c2L0 - 1.25*e*e*M.Mul(2).Sin())
 }
+
+// Times returns the times in Gregorian calendar year yr when the equation
+// of time equals E0.
+//
+// Argument eq is a function computing the equation of time, for example
+// ESmart or a closure over E.  Roots are bracketed by evaluating eq at
+// daily intervals.
+//
+// Results are JDEs in chronological order.
+func Times(yr int, E0 unit.HourAngle, eq func(float64) unit.HourAngle) []float64 {
+	f := func(jde float64) float64 { return (eq(jde) - E0).Rad() }
+	jde1 := julian.CalendarGregorianToJD(yr, 1, 1)
+	jde2 := julian.CalendarGregorianToJD(yr+1, 1, 1)
+	var t []float64
+	y0 := f(jde1)
+	for x0 := jde1; x0 < jde2; x0++ {
+		x1 := math.Min(x0+1, jde2)
+		y1 := f(x1)
+		switch {
+		case y0 == 0:
+			t = append(t, x0)
+		case y0*y1 < 0:
+			t = append(t, iterate.BinaryRoot(f, x0, x1))
+		}
+		y0 = y1
+	}
+	return t
+}
+
+// Extrema returns the times and values of the maxima and minima of the
+// equation of time in Gregorian calendar year yr.
+//
+// Argument eq is as described for Times.  Extrema are located by
+// evaluating eq at daily intervals and refined by three-point
+// interpolation.
+//
+// Results are JDEs in chronological order with corresponding values of
+// the equation of time.
+func Extrema(yr int, eq func(float64) unit.HourAngle) (jde []float64, E []unit.HourAngle) {
+	jde1 := julian.CalendarGregorianToJD(yr, 1, 1)
+	jde2 := julian.CalendarGregorianToJD(yr+1, 1, 1)
+	y := []float64{eq(jde1 - 1).Rad(), eq(jde1).Rad(), 0}
+	for x := jde1; x < jde2; x++ {
+		y[2] = eq(x + 1).Rad()
+		if (y[1]-y[0])*(y[2]-y[1]) <= 0 && y[1] != y[2] {
+			d3, err := interp.NewLen3(x-1, x+1, y)
+			if err == nil {
+				if xm, ym, err := d3.Extremum(); err == nil &&
+					xm >= jde1 && xm < jde2 {
+					jde = append(jde, xm)
+					E = append(E, unit.HourAngle(ym))
+				}
+			}
+		}
+		y[0], y[1] = y[1], y[2]
+	}
+	return
+}
@@ -5,6 +5,7 @@ package eqtime_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/soniakeys/meeus/v3/eqtime"
 	"github.com/soniakeys/meeus/v3/julian"
@@ -20,3 +21,31 @@ func ExampleESmart() {
 	// +0.0598256 rad
 	// +13ᵐ42ˢ.7
 }
+
+func ExampleTimes() {
+	// Dates in 2000 when the equation of time is zero.
+	for _, jde := range eqtime.Times(2000, 0, eqtime.ESmart) {
+		y, m, d := julian.JDToCalendar(jde)
+		fmt.Printf("%d %s %.1f\n", y, time.Month(m), d)
+	}
+	// Output:
+	// 2000 April 15.3
+	// 2000 June 13.0
+	// 2000 September 1.2
+	// 2000 December 25.0
+}
+
+func ExampleExtrema() {
+	// Extrema of the equation of time in 2000.
+	jde, E := eqtime.Extrema(2000, eqtime.ESmart)
+	for i, jde := range jde {
+		y, m, d := julian.JDToCalendar(jde)
+		fmt.Printf("%d %s %4.1f  %+.1d\n",
+			y, time.Month(m), d, sexa.FmtHourAngle(E[i]))
+	}
+	// Output:
+	// 2000 February 12.0  -14ᵐ16ˢ.6
+	// 2000 May 13.8  +3ᵐ40ˢ.6
+	// 2000 July 25.9  -6ᵐ30ˢ.3
+	// 2000 November  3.0  +16ᵐ28ˢ.2
+}
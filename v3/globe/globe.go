@@ -8,8 +8,16 @@
 package globe
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/unit"
 )
 
@@ -21,8 +29,24 @@ type Ellipsoid struct {
 	Fl float64 // flattening
 }
 
+// MarshalJSON encodes e as a JSON object with members "er" and "fl".
+func (e Ellipsoid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Er float64 `json:"er"`
+		Fl float64 `json:"fl"`
+	}{e.Er, e.Fl})
+}
+
 // IAU 1976 values.  Radius in Km.
-var Earth76 = Ellipsoid{Er: 6378.14, Fl: 1 / 298.257}
+var Earth76 = Ellipsoid{Er: base.EarthRadius, Fl: 1 / 298.257}
+
+// EarthEllipsoid returns an ellipsoid with the equatorial radius of constant
+// set s and the flattening of Earth76.
+//
+// EarthEllipsoid(&base.IAU1976) is Earth76.
+func EarthEllipsoid(s *base.ConstantSet) Ellipsoid {
+	return Ellipsoid{Er: s.EarthRadius, Fl: Earth76.Fl}
+}
 
 // A returns equatorial radius in units of e.Er.
 //
@@ -43,6 +67,14 @@ func (e Ellipsoid) Eccentricity() float64 {
 	return math.Sqrt((2 - e.Fl) * e.Fl)
 }
 
+// Parallax returns equatorial horizontal parallax of a body at distance Δ
+// from the center of the Earth.
+//
+// Δ must be in the units of e.Er.
+func (e Ellipsoid) Parallax(Δ float64) unit.Angle {
+	return unit.Angle(math.Asin(e.Er / Δ))
+}
+
 // ParallaxConstants computes parallax constants ρ sin φ′ and ρ cos φ′.
 //
 // Arguments are geographic latitude φ and height h above the ellipsoid.
@@ -117,12 +149,335 @@ func GeocentricLatitudeDifference(φ unit.Angle) unit.Angle {
 
 // Coord represents geographic coordinates on the Earth.
 //
-// Longitude is measured positively westward from the Greenwich meridian.
+// Longitude is measured positively westward from the Greenwich meridian,
+// following Meeus.  This departs from the IAU, which adopted longitude
+// positive east in 1982, and from most other modern sources, including GPS
+// receivers and ISO 6709.  Construct a Coord from such data with
+// CoordFromEastLon or ParseCoord rather than by assigning the east longitude
+// to Lon, a common cause of results for the wrong side of the world.
+//
+// All functions of the library taking a Coord, or longitude of an observer
+// as a separate argument, use the westward convention.
 type Coord struct {
 	Lat unit.Angle // latitude (φ)
 	Lon unit.Angle // longitude (ψ, or L)
 }
 
+// MarshalJSON encodes c as a JSON object with members "lat" and "lon".
+//
+// Values are in degrees.  Longitude is encoded as is, positive westward.
+func (c Coord) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.JSONValue(base.Degree))
+}
+
+// JSONValue returns a value for encoding c with package encoding/json as
+// the object of MarshalJSON, with values in unit u.
+func (c Coord) JSONValue(u base.AngleUnit) interface{} {
+	return struct {
+		Lat interface{} `json:"lat"`
+		Lon interface{} `json:"lon"`
+	}{u.JSONAngle(c.Lat), u.JSONAngle(c.Lon)}
+}
+
+// CSVHeader returns column names for the fields of CSVRecord.
+func (c Coord) CSVHeader() []string { return []string{"lat", "lon"} }
+
+// CSVRecord formats c as fields for package encoding/csv, in unit u.
+func (c Coord) CSVRecord(u base.AngleUnit) []string {
+	return []string{u.CSVAngle(c.Lat), u.CSVAngle(c.Lon)}
+}
+
+// CoordFromEastLon constructs a Coord from latitude φ and longitude λ
+// measured positively eastward from Greenwich, the convention of most
+// sources other than Meeus.
+func CoordFromEastLon(φ, λ unit.Angle) Coord {
+	return Coord{Lat: φ, Lon: -λ}
+}
+
+// LonEast returns the longitude of c measured positively eastward from
+// Greenwich.
+func (c Coord) LonEast() unit.Angle {
+	return -c.Lon
+}
+
+// Errors returned by ParseCoord.
+var (
+	ErrorCoordSyntax = errors.New("invalid geographic coordinates")
+	ErrorCoordRange  = errors.New("geographic coordinates out of range")
+)
+
+// coordMarks normalizes alternative spellings of sexagesimal unit marks.
+var coordMarks = strings.NewReplacer("′′", "″", "''", "″", "\"", "″",
+	"'", "′", "º", "°", "−", "-")
+
+// ParseCoord parses geographic coordinates, latitude and longitude.
+//
+// Each coordinate is given either in degrees, minutes, and seconds, as in
+// "43°38′12″N 79°23′W", or in decimal degrees, as in "43.6367N 79.3833W".
+// Marks ' and " may be used for ′ and ″, and the marks may be omitted when
+// the fields are separated by spaces.  Only the last field of a coordinate
+// may have a fraction.  Coordinates may be separated by a comma.
+//
+// A hemisphere letter N, S, E, or W may precede or follow each coordinate.
+// With hemisphere letters the coordinates may be given in either order.
+// Without them, latitude comes first and the coordinates are signed in the
+// convention of ISO 6709, positive north and east, as in "43.6367 -79.3833".
+// Note that this is opposite to the westward longitude of Coord.
+func ParseCoord(s string) (Coord, error) {
+	type part struct {
+		v      [3]float64 // degrees, minutes, seconds
+		next   int        // index of next unmarked field
+		frac   bool       // last field has a fraction
+		neg    bool
+		hemi   rune
+		prefix bool // hemisphere letter precedes the fields
+	}
+	var parts []*part
+	var cur *part
+	s = coordMarks.Replace(s)
+	for i := 0; i < len(s); {
+		r, w := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += w
+		case r == ',':
+			if len(parts) == 0 || cur != nil && cur.next == 0 {
+				return Coord{}, ErrorCoordSyntax
+			}
+			cur = nil
+			i += w
+		case strings.ContainsRune("NSEWnsew", r):
+			r = unicode.ToUpper(r)
+			if cur != nil && cur.next > 0 && !cur.prefix {
+				cur.hemi = r
+				cur = nil
+			} else {
+				cur = &part{hemi: r, prefix: true}
+				parts = append(parts, cur)
+			}
+			i += w
+		case r == '+' || r == '-' || r == '.' || r >= '0' && r <= '9':
+			signed := r == '+' || r == '-'
+			j := i
+			if signed {
+				j++
+			}
+			k := j
+			for k < len(s) && (s[k] == '.' || s[k] >= '0' && s[k] <= '9') {
+				k++
+			}
+			x, err := strconv.ParseFloat(s[j:k], 64)
+			if err != nil {
+				return Coord{}, ErrorCoordSyntax
+			}
+			f := -1
+			if m, w := utf8.DecodeRuneInString(s[k:]); m == '°' {
+				f, k = 0, k+w
+			} else if m == '′' {
+				f, k = 1, k+w
+			} else if m == '″' {
+				f, k = 2, k+w
+			}
+			// A field continues the current coordinate if it follows
+			// the fields already there, otherwise it starts a new one.
+			if cur == nil || cur.next > 0 && (signed || cur.frac ||
+				f >= 0 && f < cur.next || f < 0 && cur.next > 2) {
+				cur = &part{}
+				parts = append(parts, cur)
+			}
+			if signed {
+				if cur.next > 0 {
+					return Coord{}, ErrorCoordSyntax
+				}
+				cur.neg = s[i] == '-'
+			}
+			if f < 0 {
+				f = cur.next
+			}
+			cur.v[f] = x
+			cur.next = f + 1
+			cur.frac = strings.ContainsRune(s[j:k], '.')
+			i = k
+		default:
+			return Coord{}, ErrorCoordSyntax
+		}
+	}
+	if len(parts) != 2 {
+		return Coord{}, ErrorCoordSyntax
+	}
+	if parts[0].hemi == 'E' || parts[0].hemi == 'W' ||
+		parts[1].hemi == 'N' || parts[1].hemi == 'S' {
+		parts[0], parts[1] = parts[1], parts[0]
+	}
+	var deg [2]float64
+	for n, p := range parts {
+		switch {
+		case p.next == 0,
+			p.neg && p.hemi != 0,
+			n == 0 && (p.hemi == 'E' || p.hemi == 'W'),
+			n == 1 && (p.hemi == 'N' || p.hemi == 'S'):
+			return Coord{}, ErrorCoordSyntax
+		case p.v[1] >= 60 || p.v[2] >= 60:
+			return Coord{}, ErrorCoordRange
+		}
+		d := p.v[0] + p.v[1]/60 + p.v[2]/3600
+		if p.neg || p.hemi == 'S' || p.hemi == 'W' {
+			d = -d
+		}
+		deg[n] = d
+	}
+	if math.Abs(deg[0]) > 90 || math.Abs(deg[1]) > 180 {
+		return Coord{}, ErrorCoordRange
+	}
+	return CoordFromEastLon(unit.AngleFromDeg(deg[0]), unit.AngleFromDeg(deg[1])), nil
+}
+
+// FormatDMS formats c in degrees, minutes, and seconds with hemisphere
+// letters, as in "43°38′12″N 79°23′00″W".  Argument prec is the number of
+// decimal places of the seconds.
+//
+// Coord has no String method, as Observer and other types embedding Coord
+// would inherit it and print only their coordinates.
+//
+// The result can be parsed by ParseCoord.
+func (c Coord) FormatDMS(prec int) string {
+	φ := c.Lat.Deg()
+	λ := math.Remainder(c.LonEast().Deg(), 360)
+	ns, ew := 'N', 'E'
+	if φ < 0 {
+		ns, φ = 'S', -φ
+	}
+	if λ < 0 {
+		ew, λ = 'W', -λ
+	}
+	return fmt.Sprintf("%s%c %s%c", fmtDMS(φ, prec), ns, fmtDMS(λ, prec), ew)
+}
+
+// fmtDMS formats non-negative x in degrees, minutes, and seconds with prec
+// decimal places in the seconds.
+func fmtDMS(x float64, prec int) string {
+	p := int64(math.Pow(10, float64(prec)))
+	// round once so that carries propagate to minutes and degrees
+	n := int64(math.Floor(x*3600*float64(p) + .5))
+	s := n / p
+	if prec == 0 {
+		return fmt.Sprintf("%d°%02d′%02d″", s/3600, s/60%60, s%60)
+	}
+	return fmt.Sprintf("%d°%02d′%02d.%0*d″", s/3600, s/60%60, s%60, prec, n%p)
+}
+
+// FormatDecimal formats c as signed decimal degrees in the convention of
+// ISO 6709, latitude first, positive north and east, as in
+// "+43.6367 -79.3833".  Argument prec is the number of decimal places.
+//
+// The result can be parsed by ParseCoord.
+func (c Coord) FormatDecimal(prec int) string {
+	λ := math.Remainder(c.LonEast().Deg(), 360)
+	return fmt.Sprintf("%+.*f %+.*f", prec, c.Lat.Deg(), prec, λ)
+}
+
+// Observer represents the location of an observer on the Earth.
+//
+// The zero value of Datum is taken to be Earth76.
+type Observer struct {
+	Coord
+	H     float64   // height above the ellipsoid in meters
+	Datum Ellipsoid // reference ellipsoid, with Er in Km
+}
+
+// MarshalJSON encodes o as a JSON object with members "lat", "lon", "h",
+// and "datum".
+//
+// The method overrides that of the embedded Coord so that the height and
+// datum are not lost.  Angles are in degrees.
+func (o Observer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.JSONValue(base.Degree))
+}
+
+// JSONValue returns a value for encoding o with package encoding/json as
+// the object of MarshalJSON, with angles in unit u.
+func (o Observer) JSONValue(u base.AngleUnit) interface{} {
+	return struct {
+		Lat   interface{} `json:"lat"`
+		Lon   interface{} `json:"lon"`
+		H     float64     `json:"h"`
+		Datum Ellipsoid   `json:"datum"`
+	}{u.JSONAngle(o.Lat), u.JSONAngle(o.Lon), o.H, o.Datum}
+}
+
+// CSVHeader returns column names for the fields of CSVRecord.
+func (o Observer) CSVHeader() []string {
+	return []string{"lat", "lon", "h", "er", "fl"}
+}
+
+// CSVRecord formats o as fields for package encoding/csv, with angles in
+// unit u.
+func (o Observer) CSVRecord(u base.AngleUnit) []string {
+	return append(o.Coord.CSVRecord(u), formatFloat(o.H),
+		formatFloat(o.Datum.Er), formatFloat(o.Datum.Fl))
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Ellipsoid returns o.Datum, or Earth76 if o.Datum is the zero value.
+func (o Observer) Ellipsoid() Ellipsoid {
+	if o.Datum == (Ellipsoid{}) {
+		return Earth76
+	}
+	return o.Datum
+}
+
+// ParallaxConstants computes parallax constants ρ sin φ′ and ρ cos φ′ for
+// the observer.
+func (o Observer) ParallaxConstants() (ρsφʹ, ρcφʹ float64) {
+	return o.Ellipsoid().ParallaxConstants(o.Lat, o.H)
+}
+
+// MagneticDeclination is implemented by sources of magnetic declination,
+// the angle between true north and magnetic north.
+//
+// Declination returns the declination at place c and time jd, positive when
+// magnetic north is east of true north.  An implementation might return a
+// value read from a chart, interpolate a table, or evaluate a model such as
+// the World Magnetic Model from its published coefficients.
+type MagneticDeclination interface {
+	Declination(c Coord, jd float64) unit.Angle
+}
+
+// FixedDeclination is a MagneticDeclination with the same value at all
+// places and times.
+type FixedDeclination unit.Angle
+
+// Declination returns d, regardless of place and time.
+func (d FixedDeclination) Declination(Coord, float64) unit.Angle {
+	return unit.Angle(d)
+}
+
+// DeclinationFunc adapts a function to the MagneticDeclination interface.
+type DeclinationFunc func(c Coord, jd float64) unit.Angle
+
+// Declination returns f(c, jd).
+func (f DeclinationFunc) Declination(c Coord, jd float64) unit.Angle {
+	return f(c, jd)
+}
+
+// Bearing converts an azimuth to a compass bearing.
+//
+// Argument A is an azimuth as returned by coord.EqToHz, measured westward
+// from the south.  The result is measured eastward from north in the range
+// [0, 2π).  If m is nil the result is a bearing from true north, otherwise
+// it is a bearing from magnetic north using the declination m returns for
+// place c and time jd.
+func Bearing(A unit.Angle, c Coord, jd float64, m MagneticDeclination) unit.Angle {
+	b := A + math.Pi
+	if m != nil {
+		b -= m.Declination(c, jd)
+	}
+	return b.Mod1()
+}
+
 // ApproxAngularDistance returns the cosine of the angle between two points.
 //
 // The accuracy deteriorates at small angles.
@@ -166,3 +521,120 @@ func sincos2(x unit.Angle) (s2, c2 float64) {
 	s, c := x.Sincos()
 	return s * s, c * c
 }
+
+// ErrorNoConvergence is returned by Ellipsoid.Geodesic when the iteration
+// fails to converge, which happens only for nearly antipodal points.
+var ErrorNoConvergence = errors.New("Geodesic: failure to converge")
+
+// Geodesic solves the inverse geodesic problem, finding the distance and
+// azimuths between two points on an ellipsoid.
+//
+// The method is that of Vincenty, accurate to a fraction of a millimeter on
+// the Earth.  It is more accurate than Distance, at the cost of iteration.
+//
+// Result s is distance along the geodesic in units of e.Er, typically Km.
+// Results az1 and az2 are forward azimuths of the geodesic at c1 and c2,
+// measured from the North through the East.  (Note this differs from the
+// convention of coord.Horizontal.)
+//
+// ErrorNoConvergence is returned for nearly antipodal points.
+func (e Ellipsoid) Geodesic(c1, c2 Coord) (s float64, az1, az2 unit.Angle, err error) {
+	b := e.B()
+	L := (c1.Lon - c2.Lon).Rad() // eastward difference
+	sU1, cU1 := math.Sincos(math.Atan((1 - e.Fl) * c1.Lat.Tan()))
+	sU2, cU2 := math.Sincos(math.Atan((1 - e.Fl) * c2.Lat.Tan()))
+	λ := L
+	var sλ, cλ, sσ, cσ, σ, c2α, c2σm float64
+	for i := 0; ; i++ {
+		if i == 200 {
+			return 0, 0, 0, ErrorNoConvergence
+		}
+		sλ, cλ = math.Sincos(λ)
+		sσ = math.Hypot(cU2*sλ, cU1*sU2-sU1*cU2*cλ)
+		if sσ == 0 {
+			return // coincident points
+		}
+		cσ = sU1*sU2 + cU1*cU2*cλ
+		σ = math.Atan2(sσ, cσ)
+		sα := cU1 * cU2 * sλ / sσ
+		c2α = 1 - sα*sα
+		c2σm = 0
+		if c2α != 0 { // else equatorial line
+			c2σm = cσ - 2*sU1*sU2/c2α
+		}
+		C := e.Fl / 16 * c2α * (4 + e.Fl*(4-3*c2α))
+		λp := λ
+		λ = L + (1-C)*e.Fl*sα*
+			(σ+C*sσ*(c2σm+C*cσ*(-1+2*c2σm*c2σm)))
+		if math.Abs(λ-λp) < 1e-12 {
+			break
+		}
+	}
+	A, B := e.vincentyAB(c2α)
+	s = b * A * (σ - vincentyΔσ(B, sσ, cσ, c2σm))
+	az1 = unit.Angle(math.Atan2(cU2*sλ, cU1*sU2-sU1*cU2*cλ)).Mod1()
+	az2 = unit.Angle(math.Atan2(cU1*sλ, -sU1*cU2+cU1*sU2*cλ)).Mod1()
+	return
+}
+
+// GeodesicDirect solves the direct geodesic problem, finding the point at
+// a given distance and azimuth from a starting point on an ellipsoid.
+//
+// Argument c1 is the starting point, az1 the azimuth of the geodesic at c1
+// measured from the North through the East, s the distance in units of e.Er.
+//
+// Results are the end point c2 and the forward azimuth az2 there.  The
+// method is that of Vincenty, with accuracy similar to Geodesic.
+func (e Ellipsoid) GeodesicDirect(c1 Coord, az1 unit.Angle, s float64) (c2 Coord, az2 unit.Angle) {
+	b := e.B()
+	sα1, cα1 := az1.Sincos()
+	tU1 := (1 - e.Fl) * c1.Lat.Tan()
+	cU1 := 1 / math.Sqrt(1+tU1*tU1)
+	sU1 := tU1 * cU1
+	σ1 := math.Atan2(tU1, cα1)
+	sα := cU1 * sα1
+	c2α := 1 - sα*sα
+	A, B := e.vincentyAB(c2α)
+	σ0 := s / (b * A)
+	σ := σ0
+	var sσ, cσ, c2σm float64
+	for i := 0; i < 200; i++ {
+		c2σm = math.Cos(2*σ1 + σ)
+		sσ, cσ = math.Sincos(σ)
+		σp := σ
+		σ = σ0 + vincentyΔσ(B, sσ, cσ, c2σm)
+		if math.Abs(σ-σp) < 1e-12 {
+			break
+		}
+	}
+	sσ, cσ = math.Sincos(σ)
+	c2σm = math.Cos(2*σ1 + σ)
+	t := sU1*sσ - cU1*cσ*cα1
+	c2.Lat = unit.Angle(math.Atan2(sU1*cσ+cU1*sσ*cα1,
+		(1-e.Fl)*math.Hypot(sα, t)))
+	λ := math.Atan2(sσ*sα1, cU1*cσ-sU1*sσ*cα1)
+	C := e.Fl / 16 * c2α * (4 + e.Fl*(4-3*c2α))
+	L := λ - (1-C)*e.Fl*sα*
+		(σ+C*sσ*(c2σm+C*cσ*(-1+2*c2σm*c2σm)))
+	c2.Lon = (c1.Lon - unit.Angle(L)).Mod1()
+	if c2.Lon > math.Pi {
+		c2.Lon -= 2 * math.Pi
+	}
+	az2 = unit.Angle(math.Atan2(sα, -t)).Mod1()
+	return
+}
+
+// vincentyAB returns Vincenty's series coefficients A and B for the square
+// of the cosine of the azimuth of the geodesic at the equator.
+func (e Ellipsoid) vincentyAB(c2α float64) (A, B float64) {
+	a, b := e.A(), e.B()
+	u2 := c2α * (a*a - b*b) / (b * b)
+	A = 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B = u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+	return
+}
+
+func vincentyΔσ(B, sσ, cσ, c2σm float64) float64 {
+	return B * sσ * (c2σm + B/4*(cσ*(-1+2*c2σm*c2σm)-
+		B/6*c2σm*(-3+4*sσ*sσ)*(-3+4*c2σm*c2σm)))
+}
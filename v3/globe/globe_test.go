@@ -4,10 +4,12 @@
 package globe_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"testing"
 
+	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/globe"
 	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
@@ -25,6 +27,126 @@ func ExampleEllipsoid_ParallaxConstants() {
 	// ρ cos φ′ = +0.836339
 }
 
+func ExampleEarthEllipsoid() {
+	// Parallax of the Moon at the distance of example 47.a, p. 342, with
+	// the radius of the Earth of each constant set.
+	Δ := 368409.7
+	for _, s := range []*base.ConstantSet{&base.IAU1976, &base.IAU2015} {
+		π := globe.EarthEllipsoid(s).Parallax(Δ)
+		fmt.Printf("π = %.6f\n", π.Deg())
+	}
+	// Output:
+	// π = 0.991990
+	// π = 0.991984
+}
+
+func ExampleObserver_ParallaxConstants() {
+	// Example 11.a, p 82, Palomar.
+	o := globe.Observer{
+		Coord: globe.Coord{
+			Lat: unit.NewAngle(' ', 33, 21, 22),
+			Lon: unit.NewAngle(' ', 116, 51, 47),
+		},
+		H: 1706,
+	}
+	s, c := o.ParallaxConstants()
+	fmt.Printf("ρ sin φ′ = %+.6f\n", s)
+	fmt.Printf("ρ cos φ′ = %+.6f\n", c)
+	// Output:
+	// ρ sin φ′ = +0.546861
+	// ρ cos φ′ = +0.836339
+}
+
+func ExampleParseCoord() {
+	for _, s := range []string{
+		"43°38′12″N 79°23′W",
+		"79 23 W, 43 38 12 N",
+		"43.6367 -79.3833",
+	} {
+		c, err := globe.ParseCoord(s)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%s  Lon %.4f (west positive)\n", c.FormatDMS(0), c.Lon.Deg())
+	}
+	// Output:
+	// 43°38′12″N 79°23′00″W  Lon 79.3833 (west positive)
+	// 43°38′12″N 79°23′00″W  Lon 79.3833 (west positive)
+	// 43°38′12″N 79°23′00″W  Lon 79.3833 (west positive)
+}
+
+func ExampleCoord_FormatDecimal() {
+	// Palomar, p. 82.  Longitude in Coord is positive west.
+	c := globe.Coord{
+		Lat: unit.NewAngle(' ', 33, 21, 22),
+		Lon: unit.NewAngle(' ', 116, 51, 47),
+	}
+	fmt.Println(c.FormatDMS(1))
+	fmt.Println(c.FormatDecimal(4))
+	fmt.Printf("%.4f\n", c.LonEast().Deg())
+	// Output:
+	// 33°21′22.0″N 116°51′47.0″W
+	// +33.3561 -116.8631
+	// -116.8631
+}
+
+func TestParseCoord(t *testing.T) {
+	want := globe.CoordFromEastLon(unit.NewAngle(' ', 43, 38, 12),
+		unit.NewAngle('-', 79, 23, 0))
+	for _, s := range []string{
+		"43°38′12″N 79°23′W",
+		"43°38'12\"N, 79°23'W",
+		"43°38′12″N 79°23′00′′W",
+		"N 43 38 12 W 79 23",
+		"79°23′W 43°38′12″N",
+		"43 38 12, -79 23",
+		"+43°38′12″ -79°23′",
+		"43°38.2′N 79°23.0′W",
+		"43.636666666666667 −79.383333333333333",
+	} {
+		c, err := globe.ParseCoord(s)
+		if err != nil {
+			t.Errorf("%s: %v", s, err)
+			continue
+		}
+		if math.Abs((c.Lat-want.Lat).Deg()) > 1e-12 ||
+			math.Abs((c.Lon-want.Lon).Deg()) > 1e-12 {
+			t.Errorf("%s: got %s", s, c.FormatDMS(3))
+		}
+	}
+	for _, s := range []string{
+		"",
+		"43°38′12″N",
+		"43°38′12″N 79°23′E 5",
+		"43°38′12″E 79°23′W",
+		"-43°38′12″N 79°23′W",
+		"43.5 38.5 79",
+		"43°38′12″X 79°23′W",
+	} {
+		if _, err := globe.ParseCoord(s); err != globe.ErrorCoordSyntax {
+			t.Errorf("%q: got %v, want ErrorCoordSyntax", s, err)
+		}
+	}
+	for _, s := range []string{"91, 0", "43 60 0 N 79W", "0, 180.5"} {
+		if _, err := globe.ParseCoord(s); err != globe.ErrorCoordRange {
+			t.Errorf("%q: got %v, want ErrorCoordRange", s, err)
+		}
+	}
+	// round trip
+	c := globe.Coord{Lat: unit.AngleFromDeg(-33.8568), Lon: unit.AngleFromDeg(-151.2153)}
+	for _, s := range []string{c.FormatDMS(3), c.FormatDecimal(6)} {
+		p, err := globe.ParseCoord(s)
+		if err != nil {
+			t.Fatal(s, err)
+		}
+		if math.Abs((p.Lat-c.Lat).Deg()) > 1e-6 ||
+			math.Abs((p.Lon-c.Lon).Deg()) > 1e-6 {
+			t.Errorf("%s: got %s", s, p.FormatDMS(3))
+		}
+	}
+}
+
 // p. 83
 func TestLatDiff(t *testing.T) {
 	φ0 := unit.NewAngle(' ', 45, 5, 46.36)
@@ -75,3 +197,88 @@ func ExampleEllipsoid_Distance() {
 	//     d = 55°.44855
 	//     s = 6166 km
 }
+
+func ExampleEllipsoid_Geodesic() {
+	// Points of example 11.c p 85.
+	c1 := globe.Coord{
+		unit.NewAngle(' ', 48, 50, 11),
+		unit.NewAngle('-', 2, 20, 14),
+	}
+	c2 := globe.Coord{
+		unit.NewAngle(' ', 38, 55, 17),
+		unit.NewAngle(' ', 77, 3, 56),
+	}
+	s, az1, az2, err := globe.Earth76.Geodesic(c1, c2)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.3f km\n", s)
+	fmt.Printf("az1 = %.4f\n", az1.Deg())
+	fmt.Printf("az2 = %.4f\n", az2.Deg())
+	// Output:
+	// 6181.635 km
+	// az1 = 291.8336
+	// az2 = 231.7936
+}
+
+func TestGeodesicDirect(t *testing.T) {
+	c1 := globe.Coord{unit.AngleFromDeg(-37.95), unit.AngleFromDeg(-144.42)}
+	for _, c2 := range []globe.Coord{
+		{unit.AngleFromDeg(-37.65), unit.AngleFromDeg(-143.93)},
+		{unit.AngleFromDeg(51.5), unit.AngleFromDeg(.12)},
+		{unit.AngleFromDeg(0), unit.AngleFromDeg(30)},
+		{unit.AngleFromDeg(89), unit.AngleFromDeg(-170)},
+	} {
+		s, az1, az2, err := globe.Earth76.Geodesic(c1, c2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, az := globe.Earth76.GeodesicDirect(c1, az1, s)
+		// 1e-11 rad is well under a millimeter
+		if math.Abs((c.Lat-c2.Lat).Rad()) > 1e-11 ||
+			math.Abs((c.Lon-c2.Lon).Rad()) > 1e-11 ||
+			math.Abs((az-az2).Rad()) > 1e-9 {
+			t.Errorf("got %v %v, want %v %v", c, az, c2, az2)
+		}
+	}
+}
+
+func ExampleBearing() {
+	// Azimuth of the Sun setting at about 10° north of west, at a place
+	// where magnetic north is 14° west of true north.
+	c := globe.Coord{
+		Lat: unit.AngleFromDeg(42.3),
+		Lon: unit.AngleFromDeg(71.1),
+	}
+	A := unit.AngleFromDeg(100)
+	fmt.Printf("true:     %.0f°\n", globe.Bearing(A, c, 0, nil).Deg())
+	m := globe.FixedDeclination(unit.AngleFromDeg(-14))
+	fmt.Printf("magnetic: %.0f°\n", globe.Bearing(A, c, 0, m).Deg())
+	// Output:
+	// true:     280°
+	// magnetic: 294°
+}
+
+func ExampleObserver_JSONValue() {
+	// Palomar, as in example 11.a, p 82.
+	o := globe.Observer{
+		Coord: globe.Coord{
+			Lat: unit.NewAngle(' ', 33, 21, 22),
+			Lon: unit.NewAngle(' ', 116, 51, 47),
+		},
+		H:     1706,
+		Datum: globe.Earth76,
+	}
+	for _, u := range []base.AngleUnit{base.Degree, base.Sexagesimal} {
+		b, err := json.Marshal(o.JSONValue(u))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(b))
+	}
+	// Output:
+	// {"lat":33.35611111111112,"lon":116.86305555555556,"h":1706,"datum":{"er":6378.14,"fl":0.0033528131778969143}}
+	// {"lat":"+33:21:22.00","lon":"+116:51:47.00","h":1706,"datum":{"er":6378.14,"fl":0.0033528131778969143}}
+}
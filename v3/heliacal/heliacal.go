@@ -0,0 +1,145 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+// Heliacal: Heliacal rising and setting of stars and planets.
+//
+// This package does not correspond to a chapter of the book.  It estimates
+// the dates of first visibility in morning twilight (heliacal rising) and
+// last visibility in evening twilight (heliacal setting) with a simple
+// arcus visionis criterion:  an object is taken to be visible on a day when,
+// at the moment it stands at a given apparent altitude, the Sun is at least
+// the arcus visionis below the horizon.
+//
+// The arcus visionis depends on the magnitude of the object dimmed by
+// atmospheric extinction at that altitude.  It is a linear fit to the values
+// tabulated by C. Schoch, about 7.5° for Sirius and 11° for a first magnitude
+// star.  This is far simpler than a full model of twilight sky brightness
+// and eye sensitivity such as that of B. E. Schaefer and results should be
+// taken as uncertain by a few days.
+package heliacal
+
+import (
+	"errors"
+
+	"github.com/soniakeys/meeus/v3/coord"
+	"github.com/soniakeys/meeus/v3/deltat"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/refraction"
+	"github.com/soniakeys/meeus/v3/rise"
+	"github.com/soniakeys/meeus/v3/sidereal"
+	"github.com/soniakeys/meeus/v3/solar"
+	"github.com/soniakeys/unit"
+)
+
+// ErrorNotFound is returned when no heliacal event occurs in the range of
+// days searched.
+var ErrorNotFound = errors.New("no heliacal event in date range")
+
+// Object returns the apparent equatorial coordinates and visual magnitude
+// of a star or planet at time jde.
+type Object func(jde float64) (α unit.RA, δ unit.Angle, mag float64)
+
+// Star returns an Object for a star at fixed coordinates.
+//
+// Proper motion and precession are ignored, so α and δ should be for
+// a date near the dates of interest.
+func Star(α unit.RA, δ unit.Angle, mag float64) Object {
+	return func(float64) (unit.RA, unit.Angle, float64) {
+		return α, δ, mag
+	}
+}
+
+// Criterion holds parameters of the visibility criterion.
+type Criterion struct {
+	H unit.Angle // apparent altitude of the object at which Sun is tested
+	K float64    // extinction coefficient, magnitudes per air mass
+}
+
+// DefaultCriterion tests the Sun when the object is at 5° altitude, with
+// extinction typical of a clear sky near sea level.
+var DefaultCriterion = Criterion{
+	H: unit.AngleFromDeg(5),
+	K: .25,
+}
+
+// ArcusVisionis returns the minimum depression of the Sun for an object of
+// magnitude mag to be seen, where mag includes atmospheric extinction.
+func ArcusVisionis(mag float64) unit.Angle {
+	return unit.AngleFromDeg(6.3 + 1.3*mag)
+}
+
+// Visible tests the criterion c on the morning or evening of a day.
+//
+//	jd is 0h UT of the day.
+//	p is geographic coordinates of the observer, longitude positive west.
+//	morning selects the test in morning twilight as the object rises,
+//	otherwise the test is in evening twilight as the object sets.
+//
+// Result sunAlt is the altitude of the Sun when the object is at altitude
+// c.H, av is the arcus visionis required.  Err is rise.ErrorCircumpolar if
+// the object does not cross altitude c.H on the day.
+func Visible(jd float64, p globe.Coord, obj Object, c *Criterion, morning bool) (visible bool, sunAlt, av unit.Angle, err error) {
+	ΔT := deltat.Interp10A(jd)
+	α, δ, mag := obj(jd + ΔT.Day())
+	h0 := c.H - refraction.Bennett(c.H)
+	tRise, _, tSet, err := rise.ApproxTimes(p, h0, sidereal.Apparent0UT(jd), α, δ)
+	if err != nil {
+		return
+	}
+	t := tSet
+	if morning {
+		t = tRise
+	}
+	jdt := jd + t.Day()
+	αs, δs := solar.ApparentEquatorial(jdt + ΔT.Day())
+	_, sunAlt = coord.EqToHz(αs, δs, p.Lat, p.Lon, sidereal.Apparent(jdt))
+	av = ArcusVisionis(mag + c.K*refraction.AirMassKastenYoung(c.H))
+	visible = sunAlt <= -av
+	return
+}
+
+// Rising finds the date of heliacal rising, the first morning on which an
+// object is visible after a period of invisibility.
+//
+// Days are searched from jd1 through jd2, both 0h UT.  Result is 0h UT of
+// the day of heliacal rising.  ErrorNotFound is returned if the object does
+// not become visible in the range.
+func Rising(jd1, jd2 float64, p globe.Coord, obj Object, c *Criterion) (float64, error) {
+	seenInvisible := false
+	for jd := jd1; jd <= jd2; jd++ {
+		v, _, _, err := Visible(jd, p, obj, c, true)
+		switch {
+		case err != nil && err != rise.ErrorCircumpolar:
+			return 0, err
+		case !v:
+			seenInvisible = true
+		case seenInvisible:
+			return jd, nil
+		}
+	}
+	return 0, ErrorNotFound
+}
+
+// Setting finds the date of heliacal setting, the last evening on which an
+// object is visible before a period of invisibility.
+//
+// Days are searched from jd1 through jd2, both 0h UT.  Result is 0h UT of
+// the day of heliacal setting.  ErrorNotFound is returned if the object does
+// not become invisible in the range.
+func Setting(jd1, jd2 float64, p globe.Coord, obj Object, c *Criterion) (float64, error) {
+	seenVisible := false
+	for jd := jd1; jd <= jd2; jd++ {
+		v, _, _, err := Visible(jd, p, obj, c, false)
+		switch {
+		case err != nil && err != rise.ErrorCircumpolar:
+			return 0, err
+		case !v:
+			if seenVisible {
+				return jd - 1, nil
+			}
+		default:
+			seenVisible = true
+		}
+	}
+	return 0, ErrorNotFound
+}
@@ -0,0 +1,49 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package heliacal_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/heliacal"
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/unit"
+)
+
+func ExampleRising() {
+	// Sirius as seen from Cairo in 2000.
+	sirius := heliacal.Star(
+		unit.NewRA(6, 45, 8.9),
+		unit.NewAngle('-', 16, 42, 58),
+		-1.46)
+	p := globe.Coord{
+		Lat: unit.AngleFromDeg(30.04),
+		Lon: unit.AngleFromDeg(-31.24),
+	}
+	c := &heliacal.DefaultCriterion
+	jd1 := julian.CalendarGregorianToJD(2000, 4, 1)
+	jd2 := julian.CalendarGregorianToJD(2000, 9, 30)
+	set, err := heliacal.Setting(jd1, jd2, p, sirius, c)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	rise, err := heliacal.Rising(set, jd2, p, sirius, c)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, e := range []struct {
+		name string
+		jd   float64
+	}{{"Heliacal setting:", set}, {"Heliacal rising: ", rise}} {
+		_, m, d := julian.JDToCalendar(e.jd)
+		fmt.Println(e.name, time.Month(m), d)
+	}
+	// Output:
+	// Heliacal setting: May 25
+	// Heliacal rising:  August 7
+}
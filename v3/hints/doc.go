@@ -7,11 +7,14 @@
 // is in the package base.  The sections of the chapter are briefly
 // discussed here.
 //
-// Trigonometric functions of large angles:  The function base.PMod reduces
+// Trigonometric functions of large angles:  The function unit.PMod reduces
 // angles (or any floating point quantity) to a range from 0 to a given
 // positive number.  This satisfies the suggestion of this section, but see
 // the Go examples for this function.  Reducing the range of a number may
-// or may not offer accuracy advantages.
+// or may not offer accuracy advantages.  For arguments linear in time and
+// evaluated very far from the epoch, base.ReduceAngleAccurate forms and
+// reduces the angle with extended precision so that the reduced result keeps
+// full float64 precision.
 //
 // Angle modes:  Functions in the standard Go math library work in radians.
 // To avoid inefficiencies of repeated conversions, all packages of this
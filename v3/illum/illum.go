@@ -13,6 +13,7 @@ import (
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	pp "github.com/soniakeys/meeus/v3/planetposition"
 	"github.com/soniakeys/unit"
 )
 
@@ -56,6 +57,55 @@ func PhaseAngle3(L, B unit.Angle, x, y, z, Δ float64) unit.Angle {
 	return unit.Angle(math.Acos((x*cB*cL + y*cB*sL + z*sB) / Δ))
 }
 
+// PhaseAngleFromFraction computes the phase angle corresponding to an
+// illuminated fraction k.
+//
+// It is the inverse of base.Illuminated.
+func PhaseAngleFromFraction(k float64) unit.Angle {
+	return unit.Angle(math.Acos(2*k - 1))
+}
+
+// CurvePoint is a point of a phase curve, as returned by Curve.
+type CurvePoint struct {
+	JDE float64
+	I   unit.Angle // phase angle
+	K   float64    // illuminated fraction
+	Mag float64    // visual magnitude
+}
+
+// Curve computes the phase angle, illuminated fraction, and magnitude of
+// a planet over a range of dates, as for plotting a phase curve.
+//
+// Arguments pl and e must be V87Planet objects for the planet and Earth.
+// Points are computed from jde1 to jde2 at intervals of step days.
+// Argument mag is a function computing magnitude such as Mercury or Venus,
+// or a closure over a function with a different signature.
+//
+// Positions are geometric, computed without correction for light time.
+func Curve(pl, e *pp.V87Planet, jde1, jde2, step float64, mag func(r, Δ float64, i unit.Angle) float64) []CurvePoint {
+	var c []CurvePoint
+	for jde := jde1; jde <= jde2; jde += step {
+		L, B, r := pl.Position(jde)
+		L0, B0, R := e.Position(jde)
+		sB, cB := B.Sincos()
+		sL, cL := L.Sincos()
+		sB0, cB0 := B0.Sincos()
+		sL0, cL0 := L0.Sincos()
+		x := r*cB*cL - R*cB0*cL0
+		y := r*cB*sL - R*cB0*sL0
+		z := r*sB - R*sB0
+		Δ := math.Sqrt(x*x + y*y + z*z)
+		i := PhaseAngle(r, Δ, R)
+		c = append(c, CurvePoint{
+			JDE: jde,
+			I:   i,
+			K:   base.Illuminated(i),
+			Mag: mag(r, Δ, i),
+		})
+	}
+	return c
+}
+
 const p = math.Pi / 180
 
 // FractionVenus computes an approximation of the illumanted fraction of Venus.
@@ -70,6 +120,21 @@ func FractionVenus(jde float64) float64 {
 	return (s*s - 1) / 2.89332 / Δ
 }
 
+// Moon computes the visual magnitude of the Moon.
+//
+// Argument r is the Moon's distance from the Sun in AU, Δ the distance from
+// Earth in km, and i the phase angle.
+//
+// The phase law is the polynomial of Allen, Astrophysical Quantities, as
+// used by Krisciunas and Schaefer (1991), for mean distances of 1 AU and
+// 384400 km.  It does not include the opposition surge within a few degrees
+// of full Moon, where the Moon is brighter by up to several tenths of a
+// magnitude, and it does not apply during an eclipse.
+func Moon(r, Δ float64, i unit.Angle) float64 {
+	id := math.Abs(i.Deg())
+	return -12.73 + 5*math.Log10(r*Δ/384400) + .026*id + 4e-9*id*id*id*id
+}
+
 // Mercury computes the visual magnitude of Mercury.
 //
 // Argument r is the planet's distance from the Sun, Δ the distance from Earth,
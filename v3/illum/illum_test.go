@@ -6,6 +6,7 @@ package illum_test
 import (
 	"fmt"
 
+	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/illum"
 	"github.com/soniakeys/unit"
 )
@@ -50,6 +51,14 @@ func ExamplePhaseAngle3() {
 	// 0.29312
 }
 
+func ExamplePhaseAngleFromFraction() {
+	// Example 41.a, p. 284.
+	i := illum.PhaseAngleFromFraction(.647)
+	fmt.Printf("i = %.1f°\n", i.Deg())
+	// Output:
+	// i = 72.9°
+}
+
 func ExampleFractionVenus() {
 	// Example 41.b, p. 284
 	k := illum.FractionVenus(2448976.5)
@@ -66,6 +75,17 @@ func ExampleVenus() {
 	// -3.8
 }
 
+func ExampleMoon() {
+	// Full Moon at mean distances, and the Moon of example 48.a, p. 347,
+	// with distances given there.
+	fmt.Printf("%+.2f\n", illum.Moon(1, 384400, 0))
+	fmt.Printf("%+.2f\n", illum.Moon(149971520/base.AU, 368410,
+		unit.AngleFromDeg(69.0756)))
+	// Output:
+	// -12.73
+	// -10.94
+}
+
 func ExampleSaturn() {
 	// Example 41.d, p. 285
 	v := illum.Saturn(9.867882, 10.464606,
@@ -0,0 +1,37 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+// +build !nopp
+
+package illum_test
+
+import (
+	"fmt"
+
+	"github.com/soniakeys/meeus/v3/illum"
+	"github.com/soniakeys/meeus/v3/julian"
+	pp "github.com/soniakeys/meeus/v3/planetposition"
+)
+
+func ExampleCurve() {
+	// Venus, around the date of example 41.a, p. 284.
+	e, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	v, err := pp.LoadPlanet(pp.Venus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, c := range illum.Curve(v, e, 2448966.5, 2448986.5, 10, illum.Venus) {
+		y, m, d := julian.JDToCalendar(c.JDE)
+		fmt.Printf("%d %d %2.0f  i %4.1f°  k %.3f  %.1f\n",
+			y, m, d, c.I.Deg(), c.K, c.Mag)
+	}
+	// Output:
+	// 1992 12 10  i 68.6°  k 0.683  -3.7
+	// 1992 12 20  i 72.9°  k 0.647  -3.8
+	// 1992 12 30  i 77.6°  k 0.608  -3.9
+}
@@ -40,6 +40,7 @@ var (
 	ErrorExtremumOutside = errors.New("Extremum falls outside of table")
 	ErrorZeroOutside     = errors.New("Zero falls outside of table")
 	ErrorNoConverge      = errors.New("Failure to converge")
+	ErrorNoErrorEstimate = errors.New("Table too short to estimate error")
 )
 
 // Len3 allows second difference interpolation.
@@ -48,6 +49,8 @@ type Len3 struct {
 	y                  []float64
 	a, b, c            float64
 	abSum, xSum, xDiff float64
+	d3                 float64 // mean third difference, if known
+	hasD3              bool
 }
 
 // NewLen3 prepares a Len3 object from a table of three rows of x and y values.
@@ -85,11 +88,16 @@ func NewLen3(x1, x3 float64, y []float64) (*Len3, error) {
 // appropriate three rows of the table for interpolating for x, and initialize
 // the Len3 object for those rows.
 //
+// When the table has more than 3 rows, third differences adjacent to the
+// selected rows are also retained, allowing use of InterpolateXError.
+//
 //	x is the target for interpolation
 //	x1 is the x value corresponding to the first y value of the table.
 //	xn is the x value corresponding to the last y value of the table.
 //	y is all y values in the table.  len(y) should be >= 3.
 func Len3ForInterpolateX(x, x1, xn float64, y []float64) (*Len3, error) {
+	var d3 float64
+	nd3 := 0
 	if len(y) > 3 {
 		interval := (xn - x1) / float64(len(y)-1)
 		if interval == 0 {
@@ -101,11 +109,25 @@ func Len3ForInterpolateX(x, x1, xn float64, y []float64) (*Len3, error) {
 		} else if nearestX > len(y)-2 {
 			nearestX = len(y) - 2
 		}
+		// third differences using the row before or after the selection
+		if i := nearestX - 2; i >= 0 {
+			d3 += y[i+3] - 3*y[i+2] + 3*y[i+1] - y[i]
+			nd3++
+		}
+		if i := nearestX - 1; i+3 < len(y) {
+			d3 += y[i+3] - 3*y[i+2] + 3*y[i+1] - y[i]
+			nd3++
+		}
 		y = y[nearestX-1 : nearestX+2]
 		xn = x1 + float64(nearestX+1)*interval
 		x1 = x1 + float64(nearestX-1)*interval
 	}
-	return NewLen3(x1, xn, y)
+	d, err := NewLen3(x1, xn, y)
+	if err == nil && nd3 > 0 {
+		d.d3 = d3 / float64(nd3)
+		d.hasD3 = true
+	}
+	return d, err
 }
 
 // InterpolateX interpolates for a given x value.
@@ -146,6 +168,34 @@ func (d *Len3) InterpolateNStrict(n float64) (y float64, err error) {
 	return d.InterpolateN(n), nil
 }
 
+// InterpolateXError interpolates for a given x value and estimates the
+// error of the result.
+//
+// See InterpolateNError.
+func (d *Len3) InterpolateXError(x float64) (y, ε float64, err error) {
+	n := (2*x - d.xSum) / d.xDiff
+	return d.InterpolateNError(n)
+}
+
+// InterpolateNError interpolates for a given interpolating factor n and
+// estimates the error of the result.
+//
+// The error estimate ε is the magnitude of the third difference term
+// neglected by formula (3.3), n(n²-1)/6 times the third difference.
+// It is available only for Len3 objects constructed by Len3ForInterpolateX
+// from a table of more than three rows; otherwise ErrorNoErrorEstimate is
+// returned along with the interpolated y value.
+//
+// If ε is not negligible for the purpose at hand, a Len5 object constructed
+// from the surrounding five rows should be used instead.
+func (d *Len3) InterpolateNError(n float64) (y, ε float64, err error) {
+	y = d.InterpolateN(n)
+	if !d.hasD3 {
+		return y, 0, ErrorNoErrorEstimate
+	}
+	return y, math.Abs(n * (n*n - 1) / 6 * d.d3), nil
+}
+
 // Extremum returns the x and y values at the extremum.
 //
 // Results are restricted to the range of the table given to the constructor
@@ -319,6 +369,27 @@ func (d *Len5) InterpolateNStrict(n float64) (y float64, err error) {
 	return base.Horner(n, d.interpCoeff...), nil
 }
 
+// InterpolateXError interpolates for a given x value and estimates the
+// error of the result.
+//
+// See InterpolateNError.
+func (d *Len5) InterpolateXError(x float64) (y, ε float64) {
+	n := (4*x - 2*d.xSum) / d.xDiff
+	return d.InterpolateNError(n)
+}
+
+// InterpolateNError interpolates for a given interpolating factor n and
+// estimates the error of the result.
+//
+// With only five rows there is no fifth difference, so the error estimate ε
+// is the magnitude of the fourth difference term of formula (3.8),
+// n²(n²-1)/24 times the fourth difference.  This is conservative as long as
+// the differences of the table decrease with increasing order.
+func (d *Len5) InterpolateNError(n float64) (y, ε float64) {
+	n2 := n * n
+	return base.Horner(n, d.interpCoeff...), math.Abs(n2 * (n2 - 1) * d.k / 24)
+}
+
 // Extremum returns the x and y values at the extremum.
 //
 // Results are restricted to the range of the table given to the constructor
@@ -465,3 +536,37 @@ func LagrangePoly(table []struct{ X, Y float64 }) []float64 {
 	}
 	return sum
 }
+
+// Hermite performs interpolation with function values and first derivatives.
+//
+// Given a table of X values with corresponding Y values and derivatives
+// Yʹ = dY/dX, interpolate a new y value for argument x.  The interpolating
+// polynomial is of degree 2n-1 for a table of n rows, so Hermite can give
+// accuracy comparable to Lagrange or Len5 from many fewer rows, when
+// derivatives are available.
+//
+// As with Lagrange, X values in the table do not have to be equally spaced
+// or in order.  They must however, be distinct.
+func Hermite(x float64, table []struct{ X, Y, Yʹ float64 }) (y float64) {
+	// Hermite's formula expressed with Lagrange basis polynomials L,
+	//
+	//  H(x) = Σ L²(x) [ (1 - 2(x-xi) Lʹ(xi)) yi + (x-xi) yʹi ]
+	//
+	// where Lʹ(xi) = Σ 1/(xi-xj), j≠i.
+	sum := 0.
+	for i := range table {
+		xi := table[i].X
+		l := 1.
+		lʹ := 0.
+		for j := range table {
+			if i != j {
+				xj := table[j].X
+				l *= (x - xj) / (xi - xj)
+				lʹ += 1 / (xi - xj)
+			}
+		}
+		d := x - xi
+		sum += l * l * ((1-2*d*lʹ)*table[i].Y + d*table[i].Yʹ)
+	}
+	return sum
+}
@@ -6,7 +6,9 @@ package interp_test
 import (
 	"fmt"
 	"math"
+	"testing"
 
+	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/interp"
 	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
@@ -152,6 +154,66 @@ func ExampleLen5_InterpolateX() {
 	// 54′13″.369
 }
 
+func ExampleLen3_InterpolateXError() {
+	// Table of Example 3.e, p. 28.  Check whether three rows suffice.
+	yTable := []float64{
+		unit.FromSexa(' ', 0, 54, 36.125),
+		unit.FromSexa(' ', 0, 54, 24.606),
+		unit.FromSexa(' ', 0, 54, 15.486),
+		unit.FromSexa(' ', 0, 54, 08.694),
+		unit.FromSexa(' ', 0, 54, 04.133),
+	}
+	x := 28 + (3+20./60)/24
+	d3, err := interp.Len3ForInterpolateX(x, 27, 29, yTable)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, ε, err := d3.InterpolateXError(x)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Len3: %.3d  ε %.4f″\n",
+		sexa.FmtAngle(unit.AngleFromDeg(y)), ε*3600)
+	d5, err := interp.NewLen5(27, 29, yTable)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, ε = d5.InterpolateXError(x)
+	fmt.Printf("Len5: %.3d  ε %.4f″\n",
+		sexa.FmtAngle(unit.AngleFromDeg(y)), ε*3600)
+	// Output:
+	// Len3: 54′13″.366  ε 0.0036″
+	// Len5: 54′13″.369  ε 0.0001″
+}
+
+func TestLen3InterpolateNError(t *testing.T) {
+	// cubic: third difference is exact, so the estimate is the true error
+	f := func(x float64) float64 { return x * x * x }
+	d, err := interp.Len3ForInterpolateX(2.3, 0, 4,
+		[]float64{f(0), f(1), f(2), f(3), f(4)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, ε, err := d.InterpolateXError(2.3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := math.Abs(y - f(2.3)); math.Abs(e-ε) > 1e-12 {
+		t.Fatalf("error %g, estimate %g", e, ε)
+	}
+	// no estimate from a three row table
+	d, err = interp.NewLen3(1, 3, []float64{f(1), f(2), f(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = d.InterpolateXError(2.3); err != interp.ErrorNoErrorEstimate {
+		t.Fatal("expected ErrorNoErrorEstimate")
+	}
+}
+
 func ExampleLen5_Zero() {
 	// Exercise, p. 30.
 	x1 := 25.
@@ -255,3 +317,38 @@ func ExampleLagrangePoly() {
 	// -13
 	// 1
 }
+
+func ExampleHermite() {
+	// Interpolate sin(x) at x = .5 from three rows.
+	h := []struct{ X, Y, Yʹ float64 }{}
+	l := []struct{ X, Y float64 }{}
+	for _, x := range []float64{0, 1, 2} {
+		h = append(h, struct{ X, Y, Yʹ float64 }{x, math.Sin(x), math.Cos(x)})
+		l = append(l, struct{ X, Y float64 }{x, math.Sin(x)})
+	}
+	fmt.Printf("sin(.5):  %.6f\n", math.Sin(.5))
+	fmt.Printf("Hermite:  %.6f\n", interp.Hermite(.5, h))
+	fmt.Printf("Lagrange: %.6f\n", interp.Lagrange(.5, l))
+	// Output:
+	// sin(.5):  0.479426
+	// Hermite:  0.479576
+	// Lagrange: 0.517441
+}
+
+func TestHermite(t *testing.T) {
+	// exact for a polynomial of degree 2n-1, here a quintic from 3 rows
+	// at unequal intervals.
+	c := []float64{1, -2, 3, -4, 5, -6}
+	dc := []float64{-2, 6, -12, 20, -30}
+	var table []struct{ X, Y, Yʹ float64 }
+	for _, x := range []float64{-1, .5, 2} {
+		table = append(table, struct{ X, Y, Yʹ float64 }{
+			x, base.Horner(x, c...), base.Horner(x, dc...)})
+	}
+	for x := -1.; x <= 2; x += .25 {
+		y := interp.Hermite(x, table)
+		if want := base.Horner(x, c...); math.Abs(y-want) > 1e-9 {
+			t.Errorf("x = %g: got %g, want %g", x, y, want)
+		}
+	}
+}
@@ -20,6 +20,7 @@
 package jm
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
@@ -254,3 +255,215 @@ var mmonths = [12]string{
 
 // String returns the Romanization of the month ("Muḥarram", "Ṣafar", ...).
 func (m MMonth) String() string { return mmonths[m-1] }
+
+// An HMonth specifies a month of the Jewish calendar.
+//
+// Months are numbered from Nisan as in the Bible, although the year number
+// changes at Tishri, the seventh month.  In a leap year, Adar is the first
+// Adar (Adar I) and AdarII is the added thirteenth month.
+type HMonth int
+
+// Months of the Jewish calendar.
+const (
+	Nisan HMonth = 1 + iota
+	Iyyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishri
+	Marheshvan
+	Kislev
+	Tevet
+	Shevat
+	Adar
+	AdarII
+)
+
+var hmonths = [13]string{
+	"Nisan",
+	"Iyyar",
+	"Sivan",
+	"Tammuz",
+	"Av",
+	"Elul",
+	"Tishri",
+	"Marḥeshvan",
+	"Kislev",
+	"Tevet",
+	"Shevat",
+	"Adar",
+	"Adar II",
+}
+
+// String returns the Romanization of the month ("Nisan", "Iyyar", ...).
+func (m HMonth) String() string { return hmonths[m-1] }
+
+// hebrewEpoch is the JD at the beginning (midnight) of 1 Tishri of year 1.
+const hebrewEpoch = 347997.5
+
+// Day and parts (1/25920 day) of the molad of Tishri of year 1, counted from
+// hebrewEpoch.  It is 5 hours 204 parts after 6pm on the day before, the
+// molad "BaHaRaD."
+const molad1 = -876 / 25920.
+
+// Length of the mean lunar month of the Jewish calendar, 29 days 12 hours
+// 793 parts.
+const hebrewMonth = 29.5 + 793/25920.
+
+// HebrewLeapYear returns true if year y of the Jewish calendar has
+// thirteen months.
+func HebrewLeapYear(y int) bool {
+	return imod(7*y+1, 19) < 7
+}
+
+// imod returns x modulo y in the range [0, y).
+func imod(x, y int) int {
+	return x - y*base.FloorDiv(x, y)
+}
+
+// monthsElapsed returns the number of months from the molad of Tishri of
+// year 1 to the molad of Tishri of year y.
+func monthsElapsed(y int) int {
+	return base.FloorDiv(235*y-234, 19)
+}
+
+// elapsedDays returns the number of days from hebrewEpoch to the molad of
+// Tishri of year y, delayed by one day when the molad is at noon or later
+// and further delayed so that 1 Tishri does not fall on Sunday, Wednesday,
+// or Friday.
+func elapsedDays(y int) int {
+	m := monthsElapsed(y)
+	parts := 12084 + 13753*m
+	d := 29*m + base.FloorDiv(parts, 25920)
+	if imod(3*(d+1), 7) < 3 {
+		d++
+	}
+	return d
+}
+
+// newYear returns the number of days from hebrewEpoch to 1 Tishri of year y.
+func newYear(y int) int {
+	ny0, ny1, ny2 := elapsedDays(y-1), elapsedDays(y), elapsedDays(y+1)
+	switch {
+	case ny2-ny1 == 356:
+		return ny1 + 2
+	case ny1-ny0 == 382:
+		return ny1 + 1
+	}
+	return ny1
+}
+
+// HebrewYearDays returns the number of days in year y of the Jewish
+// calendar.
+//
+// The result is one of 353, 354, or 355 for common years and one of 383,
+// 384, or 385 for leap years.
+func HebrewYearDays(y int) int {
+	return newYear(y+1) - newYear(y)
+}
+
+// HebrewMonthDays returns the number of days in month m of year y of the
+// Jewish calendar.
+func HebrewMonthDays(y int, m HMonth) int {
+	switch m {
+	case Iyyar, Tammuz, Elul, Tevet, AdarII:
+		return 29
+	case Adar:
+		if !HebrewLeapYear(y) {
+			return 29
+		}
+	case Marheshvan:
+		if HebrewYearDays(y)%10 != 5 {
+			return 29
+		}
+	case Kislev:
+		if HebrewYearDays(y)%10 == 3 {
+			return 29
+		}
+	}
+	return 30
+}
+
+// lastMonth returns the last month of the Jewish year y.
+func lastMonth(y int) HMonth {
+	if HebrewLeapYear(y) {
+		return AdarII
+	}
+	return Adar
+}
+
+// HebrewDate is a date of the Jewish calendar.
+type HebrewDate struct {
+	Year  int
+	Month HMonth
+	Day   int
+}
+
+// String returns the date as day, month, year, for example "15 Nisan 5785".
+func (d HebrewDate) String() string {
+	return fmt.Sprintf("%d %s %d", d.Day, d.Month, d.Year)
+}
+
+// JD returns the Julian day of the date.
+//
+// The result is for midnight at the beginning of the civil day of the date.
+// Note the day of the Jewish calendar begins at the previous sunset.
+func (d HebrewDate) JD() float64 {
+	n := newYear(d.Year) + d.Day - 1
+	if d.Month < Tishri {
+		for m := Tishri; m <= lastMonth(d.Year); m++ {
+			n += HebrewMonthDays(d.Year, m)
+		}
+		for m := Nisan; m < d.Month; m++ {
+			n += HebrewMonthDays(d.Year, m)
+		}
+	} else {
+		for m := Tishri; m < d.Month; m++ {
+			n += HebrewMonthDays(d.Year, m)
+		}
+	}
+	return hebrewEpoch + float64(n)
+}
+
+// JDToHebrew returns the date of the Jewish calendar of the civil day
+// containing jd.
+func JDToHebrew(jd float64) HebrewDate {
+	n := int(math.Floor(jd - hebrewEpoch))
+	// approximate year from the mean length of the year, then correct
+	y := int(float64(n)/(35975351./98496)) + 1
+	for newYear(y) > n {
+		y--
+	}
+	for newYear(y+1) <= n {
+		y++
+	}
+	d := HebrewDate{Year: y, Month: Tishri, Day: n - newYear(y) + 1}
+	for {
+		l := HebrewMonthDays(y, d.Month)
+		if d.Day <= l {
+			return d
+		}
+		d.Day -= l
+		if d.Month == lastMonth(y) {
+			d.Month = Nisan
+		} else {
+			d.Month++
+		}
+	}
+}
+
+// Molad returns the molad, the mean new moon of the Jewish reckoning, of
+// month m of year y.
+//
+// The result is a JD in Jerusalem mean time, which is about 2ʰ21ᵐ ahead of
+// UT.
+func Molad(y int, m HMonth) float64 {
+	// months from the molad of Tishri of year y
+	n := int(m - Tishri)
+	if m < Tishri {
+		n = int(m-Nisan) + int(lastMonth(y)-Tishri) + 1
+	}
+	return hebrewEpoch + molad1 +
+		float64(monthsElapsed(y)+n)*hebrewMonth
+}
@@ -5,9 +5,14 @@ package jm_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
 	"time"
 
 	"github.com/soniakeys/meeus/v3/jm"
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/sexagesimal"
+	"github.com/soniakeys/unit"
 )
 
 func ExampleJewishCalendar() {
@@ -68,3 +73,49 @@ func ExampleJulianToMoslem() {
 	// Output:
 	// 2 Ṣafar of A.H. 1412
 }
+
+func ExampleJDToHebrew() {
+	// Pesach of example 9.a, p. 73.
+	d := jm.JDToHebrew(julian.CalendarGregorianToJD(1990, 4, 10))
+	fmt.Println(d)
+	y, m, dd := julian.JDToCalendar(jm.HebrewDate{Year: 5750, Month: jm.Tishri, Day: 1}.JD())
+	fmt.Println("1 Tishri 5750:", y, time.Month(m), dd)
+	// Output:
+	// 15 Nisan 5750
+	// 1 Tishri 5750: 1989 September 30
+}
+
+func ExampleMolad() {
+	// Molad of Tishri of year 5785.
+	j := jm.Molad(5785, jm.Tishri)
+	y, m, d := julian.JDToCalendar(j)
+	fd := d - math.Floor(d)
+	fmt.Printf("%s %d %s %d  %.0s Jerusalem mean time\n",
+		time.Weekday(julian.DayOfWeek(j)), int(d), time.Month(m), y,
+		sexa.FmtTime(unit.TimeFromDay(fd)))
+	// Output:
+	// Thursday 3 October 2024  3ʰ21ᵐ43ˢ Jerusalem mean time
+}
+
+func TestHebrew(t *testing.T) {
+	for y := 1583; y <= 2400; y++ {
+		A, mP, dP, mNY, dNY, _, days := jm.JewishCalendar(y)
+		p := jm.HebrewDate{Year: A, Month: jm.Nisan, Day: 15}
+		if got, want := p.JD(), julian.CalendarGregorianToJD(y, mP, float64(dP)); got != want {
+			t.Fatal(y, "Pesach", got, want)
+		}
+		ny := jm.HebrewDate{Year: A + 1, Month: jm.Tishri, Day: 1}
+		if got, want := ny.JD(), julian.CalendarGregorianToJD(y, mNY, float64(dNY)); got != want {
+			t.Fatal(y, "New Year", got, want)
+		}
+		if got := jm.HebrewYearDays(A + 1); got != days {
+			t.Fatal(y, "days", got, days)
+		}
+		// round trip every day of the year
+		for jd := ny.JD(); jd < ny.JD()+float64(days); jd++ {
+			if got := jm.JDToHebrew(jd).JD(); got != jd {
+				t.Fatal(jm.JDToHebrew(jd), got, jd)
+			}
+		}
+	}
+}
@@ -16,6 +16,7 @@
 package julian
 
 import (
+	"errors"
 	"math"
 	"time"
 
@@ -27,6 +28,11 @@ import (
 //
 // Negative years are valid, back to JD 0.  The result is not valid for
 // dates before JD 0.
+//
+// The date is taken as Gregorian even before the introduction of the
+// Gregorian calendar in 1582, that is, the proleptic Gregorian calendar.
+// The date is not validated.  See CalendarToJD for validation and for
+// automatic selection of the calendar.
 func CalendarGregorianToJD(y, m int, d float64) float64 {
 	switch m {
 	case 1, 2:
@@ -54,6 +60,78 @@ func CalendarJulianToJD(y, m int, d float64) float64 {
 		float64(base.FloorDiv(306*(m+1), 10)) + d - 1524.5
 }
 
+// CalendarMode selects the calendar used by CalendarToJD.
+type CalendarMode int
+
+// Calendar modes for CalendarToJD.
+const (
+	// Switchover interprets dates before 1582 October 15 as Julian and
+	// dates from then on as Gregorian.  This is the convention of
+	// JDToCalendar.
+	Switchover CalendarMode = iota
+	// ProlepticGregorian interprets all dates as Gregorian.
+	ProlepticGregorian
+	// ProlepticJulian interprets all dates as Julian.
+	ProlepticJulian
+)
+
+// Errors returned by CalendarToJD.
+var (
+	ErrorInvalidDate = errors.New("Invalid calendar date")
+	ErrorSkippedDate = errors.New("Date skipped in change to Gregorian calendar")
+)
+
+// CalendarToJD converts a year, month, and day of month to Julian day,
+// validating the date.
+//
+// Argument mode selects the calendar.  With Switchover, the dates 1582
+// October 5 through 14, which do not exist in either calendar as used,
+// return ErrorSkippedDate.
+//
+// ErrorInvalidDate is returned for a month outside 1 to 12 or a day outside
+// the month, such as February 30 or February 29 of a common year.  The day
+// may have a fractional part for the time of day.
+func CalendarToJD(y, m int, d float64, mode CalendarMode) (float64, error) {
+	if m < 1 || m > 12 || d < 1 {
+		return 0, ErrorInvalidDate
+	}
+	gregorian := mode == ProlepticGregorian
+	if mode == Switchover {
+		switch {
+		case y > 1582 || y == 1582 && (m > 10 || m == 10 && d >= 15):
+			gregorian = true
+		case y == 1582 && m == 10 && d >= 5:
+			return 0, ErrorSkippedDate
+		}
+	}
+	leap := LeapYearJulian(y)
+	if gregorian {
+		leap = LeapYearGregorian(y)
+	}
+	if d >= float64(DaysInMonth(m, leap)+1) {
+		return 0, ErrorInvalidDate
+	}
+	if gregorian {
+		return CalendarGregorianToJD(y, m, d), nil
+	}
+	return CalendarJulianToJD(y, m, d), nil
+}
+
+// DaysInMonth returns the number of days in month m, 1 to 12, of a common
+// or leap year.
+func DaysInMonth(m int, leap bool) int {
+	switch m {
+	case 2:
+		if leap {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	}
+	return 31
+}
+
 // LeapYearJulian returns true if year y in the Julian calendar is a leap year.
 func LeapYearJulian(y int) bool {
 	return y%4 == 0
@@ -67,12 +145,14 @@ func LeapYearGregorian(y int) bool {
 // JDToCalendar returns the calendar date for the given jd.
 //
 // Note that this function returns a date in either the Julian or Gregorian
-// Calendar, as appropriate.
+// Calendar, as appropriate.  Dates from 1582 October 15 are Gregorian,
+// earlier dates are Julian.  This is the inverse of CalendarToJD with mode
+// Switchover.
 func JDToCalendar(jd float64) (year, month int, day float64) {
 	zf, f := math.Modf(jd + .5)
 	z := int64(zf)
 	a := z
-	if z >= 2299151 {
+	if z >= 2299161 { // p. 63
 		α := base.FloorDiv64(z*100-186721625, 3652425)
 		a = z + 1 + α - base.FloorDiv64(α, 4)
 	}
@@ -97,12 +177,14 @@ func JDToCalendar(jd float64) (year, month int, day float64) {
 	return
 }
 
-// jdToCalendarGregorian returns the Gregorian calendar date for the given jd.
+// JDToCalendarGregorian returns the Gregorian calendar date for the given jd.
 //
 // Note that it returns a Gregorian date even for dates before the start of
-// the Gregorian calendar.  The function is useful when working with Go
-// time.Time values because they are always based on the Gregorian calendar.
-func jdToCalendarGregorian(jd float64) (year, month int, day float64) {
+// the Gregorian calendar, that is, a date of the proleptic Gregorian
+// calendar.  It is the inverse of CalendarGregorianToJD.  The function is
+// useful when working with Go time.Time values because they are always
+// based on the Gregorian calendar.
+func JDToCalendarGregorian(jd float64) (year, month int, day float64) {
 	zf, f := math.Modf(jd + .5)
 	z := int64(zf)
 	α := base.FloorDiv64(z*100-186721625, 3652425)
@@ -131,7 +213,7 @@ func jdToCalendarGregorian(jd float64) (year, month int, day float64) {
 // JDToTime takes a JD and returns a Go time.Time value.
 func JDToTime(jd float64) time.Time {
 	// time.Time is always Gregorian
-	y, m, d := jdToCalendarGregorian(jd)
+	y, m, d := JDToCalendarGregorian(jd)
 	t := time.Date(y, time.Month(m), 0, 0, 0, 0, 0, time.UTC)
 	return t.Add(time.Duration(d * 24 * float64(time.Hour)))
 }
@@ -157,6 +239,32 @@ func DayOfWeek(jd float64) int {
 	return int(jd+1.5) % 7
 }
 
+// StartOfDayUT returns the Julian day of 0h of the day containing jd.
+//
+// The result is the value needed for example by sidereal.Apparent0UT and
+// the rise functions.  The day is that of the time scale of jd, normally
+// UT.
+func StartOfDayUT(jd float64) float64 {
+	return math.Floor(jd-.5) + .5
+}
+
+// MidnightNearest returns the Julian day of the 0h nearest jd.
+func MidnightNearest(jd float64) float64 {
+	return math.Floor(jd) + .5
+}
+
+// AddDays returns jd advanced by n whole days, preserving the time of day.
+func AddDays(jd float64, n int) float64 {
+	d0 := StartOfDayUT(jd)
+	return d0 + float64(n) + (jd - d0)
+}
+
+// SameGregorianDay returns true if jd1 and jd2 fall on the same calendar
+// day, that is, between the same two instants of 0h.
+func SameGregorianDay(jd1, jd2 float64) bool {
+	return StartOfDayUT(jd1) == StartOfDayUT(jd2)
+}
+
 // DayOfYearGregorian computes the day number within the year of the Gregorian
 // calendar.
 func DayOfYearGregorian(y, m, d int) int {
@@ -118,6 +118,65 @@ func TestGregLeap(t *testing.T) {
 	}
 }
 
+func ExampleCalendarToJD() {
+	for _, d := range []struct {
+		y, m int
+		d    float64
+	}{
+		{1582, 10, 4},  // last day of the Julian calendar
+		{1582, 10, 10}, // skipped
+		{1582, 10, 15}, // first day of the Gregorian calendar
+		{1900, 2, 29},  // not a leap year in the Gregorian calendar
+	} {
+		for _, mode := range []julian.CalendarMode{
+			julian.Switchover, julian.ProlepticGregorian} {
+			jd, err := julian.CalendarToJD(d.y, d.m, d.d, mode)
+			if err != nil {
+				fmt.Printf("%d %d %2.0f  %s\n", d.y, d.m, d.d, err)
+			} else {
+				fmt.Printf("%d %d %2.0f  %.1f\n", d.y, d.m, d.d, jd)
+			}
+		}
+	}
+	// Output:
+	// 1582 10  4  2299159.5
+	// 1582 10  4  2299149.5
+	// 1582 10 10  Date skipped in change to Gregorian calendar
+	// 1582 10 10  2299155.5
+	// 1582 10 15  2299160.5
+	// 1582 10 15  2299160.5
+	// 1900 2 29  Invalid calendar date
+	// 1900 2 29  Invalid calendar date
+}
+
+// Dates accepted by CalendarToJD round trip through JDToCalendar.
+func TestCalendarToJD(t *testing.T) {
+	for _, y := range []int{-1000, -4, 1, 1582, 1900, 2000, 2023} {
+		for m := 1; m <= 12; m++ {
+			for d := 1; d <= 32; d++ {
+				jd, err := julian.CalendarToJD(y, m, float64(d), julian.Switchover)
+				if err != nil {
+					continue
+				}
+				y2, m2, d2 := julian.JDToCalendar(jd)
+				if y2 != y || m2 != m || d2 != float64(d) {
+					t.Fatalf("%d %d %d: %v %d %d %v", y, m, d, jd, y2, m2, d2)
+				}
+			}
+		}
+	}
+	for _, d := range []struct{ y, m, d int }{
+		{2023, 2, 29}, {2024, 2, 30}, {2023, 4, 31}, {2023, 13, 1}, {2023, 1, 0},
+	} {
+		if _, err := julian.CalendarToJD(d.y, d.m, float64(d.d), julian.ProlepticGregorian); err != julian.ErrorInvalidDate {
+			t.Fatal(d, err)
+		}
+	}
+	if _, err := julian.CalendarToJD(1500, 2, 29, julian.Switchover); err != nil {
+		t.Fatal("1500 is a leap year in the Julian calendar")
+	}
+}
+
 func ExampleJDToCalendar() {
 	// Example 7.c, p. 64.
 	y, m, d := julian.JDToCalendar(2436116.31)
@@ -143,6 +202,21 @@ func TestYMD(t *testing.T) {
 	}
 }
 
+func TestJDToCalendarGregorian(t *testing.T) {
+	// 333 January 27.5 Julian, of TestYMD, is January 28.5 proleptic
+	// Gregorian.
+	y, m, d := julian.JDToCalendarGregorian(1842713)
+	if y != 333 || m != 1 || d != 28.5 {
+		t.Fatal("JDToCalendarGregorian", y, m, d)
+	}
+	for _, jd := range []float64{1842713, 1507900.13, 2299160.5, 2436116.31} {
+		y, m, d := julian.JDToCalendarGregorian(jd)
+		if j := julian.CalendarGregorianToJD(y, m, d); math.Abs(j-jd) > 1e-6 {
+			t.Fatal("CalendarGregorianToJD", y, m, d, j, "want", jd)
+		}
+	}
+}
+
 func ExampleDayOfWeek() {
 	// Example 7.e, p. 65.
 	fmt.Println(time.Weekday(julian.DayOfWeek(2434923.5)))
@@ -150,6 +224,20 @@ func ExampleDayOfWeek() {
 	// Wednesday
 }
 
+func ExampleStartOfDayUT() {
+	// Example 12.a, p. 88.
+	jd := julian.CalendarGregorianToJD(1987, 4, 10.8)
+	fmt.Printf("%.1f\n", julian.StartOfDayUT(jd))
+	fmt.Printf("%.1f\n", julian.MidnightNearest(jd))
+	fmt.Println(julian.SameGregorianDay(jd, julian.AddDays(jd, 1)))
+	fmt.Printf("%.1f\n", julian.AddDays(jd, -10))
+	// Output:
+	// 2446895.5
+	// 2446896.5
+	// false
+	// 2446886.3
+}
+
 func ExampleDayOfYear_f() {
 	// Example 7.f, p. 65.
 	fmt.Println(julian.DayOfYear(1978, 11, 14, false))
@@ -7,7 +7,9 @@ package jupiter
 import (
 	"math"
 
+	"github.com/soniakeys/meeus/v3/angle"
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/nutation"
 	pp "github.com/soniakeys/meeus/v3/planetposition"
 	"github.com/soniakeys/unit"
@@ -24,8 +26,28 @@ import (
 //	    as seen from Earth.
 //	P   Geocentric position angle of Jupiter's northern rotation pole.
 func Physical(jde float64, earth, jupiter *pp.V87Planet) (DS, DE, ω1, ω2, P unit.Angle) {
+	DS, DE, ω1, ω2, _, P = physical(jde, earth, jupiter)
+	return
+}
+
+// CentralMeridians returns longitudes of the central meridian of Jupiter
+// in Systems I, II, and III.
+//
+// Results ω1 and ω2 are the same as those returned by Physical.
+//
+// Result ω3 is the System III longitude, based on the rotation of Jupiter's
+// magnetic field and used for radio observations.  It uses the IAU
+// rotation rate of 870.536° per day.  As is conventional for System III,
+// ω3 is the longitude of the geometric central meridian, without the
+// correction for phase applied to ω1 and ω2.
+func CentralMeridians(jde float64, earth, jupiter *pp.V87Planet) (ω1, ω2, ω3 unit.Angle) {
+	_, _, ω1, ω2, ω3, _ = physical(jde, earth, jupiter)
+	return
+}
+
+func physical(jde float64, earth, jupiter *pp.V87Planet) (DS, DE, ω1, ω2, ω3, P unit.Angle) {
 	// Step 1.
-	d := jde - 2433282.5
+	d := jde - base.J1950
 	T1 := d / base.JulianCentury
 	const p = math.Pi / 180
 	α0 := 268*p + .1061*p*T1
@@ -82,6 +104,9 @@ func Physical(jde float64, earth, jupiter *pp.V87Planet) (DS, DE, ω1, ω2, P un
 	// Step 13.
 	ω1 = unit.Angle(W1 - ζ - 5.07033*p*Δ)
 	ω2 = unit.Angle(W2 - ζ - 5.02626*p*Δ)
+	// System III, IAU rotation from J2000, light time as in Step 13.
+	W3 := 284.95*p + 870.536*p*(jde-base.J2000)
+	ω3 = unit.Angle(W3 - ζ - 870.536*p*base.LightTime(Δ)).Mod1()
 	// Step 14.
 	C := unit.Angle((2*r*Δ + R*R - r*r - Δ*Δ) / (4 * r * Δ))
 	if (l - l0).Sin() < 0 {
@@ -110,14 +135,10 @@ func Physical(jde float64, earth, jupiter *pp.V87Planet) (DS, DE, ω1, ω2, P un
 	α0ʹ := α0 + Δα0
 	δ0ʹ := δ0 + Δδ0
 	// Step 18.
-	sδʹ, cδʹ := math.Sincos(δʹ)
-	sδ0ʹ, cδ0ʹ := math.Sincos(δ0ʹ)
-	sα0ʹαʹ, cα0ʹαʹ := math.Sincos(α0ʹ - αʹ)
 	// (42.4) p. 290
-	P = unit.Angle(math.Atan2(cδ0ʹ*sα0ʹαʹ, sδ0ʹ*cδʹ-cδ0ʹ*sδʹ*cα0ʹαʹ))
-	if P < 0 {
-		P += 2 * math.Pi
-	}
+	P = angle.PositionAngle(
+		&coord.Equatorial{RA: unit.RA(αʹ), Dec: unit.Angle(δʹ)},
+		&coord.Equatorial{RA: unit.RA(α0ʹ), Dec: unit.Angle(δ0ʹ)})
 	return
 }
 
@@ -135,6 +156,11 @@ func Physical(jde float64, earth, jupiter *pp.V87Planet) (DS, DE, ω1, ω2, P un
 //
 // All angular results in radians.
 func Physical2(jde float64) (DS, DE, ω1, ω2 unit.Angle) {
+	DS, DE, ω1, ω2, _ = physical2(jde)
+	return
+}
+
+func physical2(jde float64) (DS, DE, ω1, ω2, ω3 unit.Angle) {
 	d := jde - base.J2000
 	const p = math.Pi / 180
 	V := 172.74*p + .00111588*p*d
@@ -157,6 +183,9 @@ func Physical2(jde float64) (DS, DE, ω1, ω2 unit.Angle) {
 	dd := d - Δ/173
 	ω1 = unit.Angle(210.98*p + 877.8169088*p*dd + ψ - B)
 	ω2 = unit.Angle(187.23*p + 870.1869088*p*dd + ψ - B)
+	// System III differs from System II by the difference in rotation
+	// rates.  At J2000, IAU W3 - W2 = 241.5907°.
+	ω3 = unit.Angle((187.23+241.5907)*p + 870.4528734*p*dd + ψ - B).Mod1()
 	C := unit.Angle(math.Sin(ψ / 2))
 	C *= C
 	if sK > 0 {
@@ -170,3 +199,16 @@ func Physical2(jde float64) (DS, DE, ω1, ω2 unit.Angle) {
 		unit.Angle(1.3*p*(r-Δ)/Δ*math.Sin(λ-100.5*p))
 	return
 }
+
+// CentralMeridians2 returns longitudes of the central meridian of Jupiter
+// in Systems I, II, and III.
+//
+// Results are less accurate than with CentralMeridians.
+//
+// Results ω1 and ω2 are the same as those returned by Physical2.  Result ω3
+// is the System III longitude of the geometric central meridian as
+// described for CentralMeridians.
+func CentralMeridians2(jde float64) (ω1, ω2, ω3 unit.Angle) {
+	_, _, ω1, ω2, ω3 = physical2(jde)
+	return
+}
@@ -22,3 +22,15 @@ func ExamplePhysical2() {
 	// ω1 = 268.12
 	// ω2 = 72.79
 }
+
+func ExampleCentralMeridians2() {
+	// Example 43.b, p. 299
+	ω1, ω2, ω3 := jupiter.CentralMeridians2(2448972.50068)
+	fmt.Printf("ω1 = %.2f\n", ω1.Deg())
+	fmt.Printf("ω2 = %.2f\n", ω2.Deg())
+	fmt.Printf("ω3 = %.2f\n", ω3.Deg())
+	// Output:
+	// ω1 = 268.12
+	// ω2 = 72.79
+	// ω3 = 349.75
+}
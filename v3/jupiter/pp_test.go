@@ -7,6 +7,8 @@ package jupiter_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
 
 	"github.com/soniakeys/meeus/v3/jupiter"
 	pp "github.com/soniakeys/meeus/v3/planetposition"
@@ -37,3 +39,26 @@ func ExamplePhysical() {
 	// ω2 = 72.74
 	// P = 24.80
 }
+
+func TestCentralMeridians(t *testing.T) {
+	e, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		t.Skip(err)
+	}
+	j, err := pp.LoadPlanet(pp.Jupiter)
+	if err != nil {
+		t.Skip(err)
+	}
+	jde := 2448972.50068
+	_, _, ω1, ω2, _ := jupiter.Physical(jde, e, j)
+	c1, c2, c3 := jupiter.CentralMeridians(jde, e, j)
+	if c1 != ω1 || c2 != ω2 {
+		t.Errorf("ω1, ω2 = %v, %v, Physical gives %v, %v", c1, c2, ω1, ω2)
+	}
+	// agreement with the lower accuracy method, allowing for the phase
+	// correction.
+	_, _, a3 := jupiter.CentralMeridians2(jde)
+	if d := math.Abs((c3 - a3).Deg()); d > .1 {
+		t.Errorf("ω3 = %.2f, CentralMeridians2 gives %.2f", c3.Deg(), a3.Deg())
+	}
+}
@@ -6,11 +6,13 @@ package jupitermoons
 
 import (
 	"math"
+	"sort"
 
 	"github.com/soniakeys/meeus/v3/base"
 	pe "github.com/soniakeys/meeus/v3/planetelements"
 	pp "github.com/soniakeys/meeus/v3/planetposition"
 	"github.com/soniakeys/meeus/v3/solar"
+	"github.com/soniakeys/unit"
 )
 
 // XY used for returning coordinates of moons.
@@ -78,31 +80,82 @@ func Positions(jde float64) (pI, pII, pIII, pIV XY) {
 // argument pos, which must not be nil.  Returned coordinates in units
 // of Jupiter radii.
 func E5(jde float64, earth, jupiter *pp.V87Planet, pos *[4]XY) {
-	// variables assigned in following block
-	var λ0, β0, t float64
-	Δ := 5.
-	{
-		s, β, R := solar.TrueVSOP87(earth, jde)
-		ss, cs := math.Sincos(s.Rad())
-		sβ := math.Sin(β.Rad())
-		τ := base.LightTime(Δ)
-		var x, y, z float64
-		f := func() {
-			l, b, r := jupiter.Position(jde - τ)
-			sl, cl := math.Sincos(l.Rad())
-			sb, cb := math.Sincos(b.Rad())
-			x = r*cb*cl + R*cs
-			y = r*cb*sl + R*ss
-			z = r*sb + R*sβ
-			Δ = math.Sqrt(x*x + y*y + z*z)
-			τ = base.LightTime(Δ)
-		}
-		f()
-		f()
-		λ0 = math.Atan2(y, x)
-		β0 = math.Atan(z / math.Hypot(x, y))
-		t = jde - 2443000.5 - τ
+	λ0, β0, Δ, τ := geocentric(jde, earth, jupiter)
+	var r [4]xyz
+	e5(jde, jde-τ, λ0, β0, Δ, &r)
+	for i := range r {
+		pos[i] = XY{r[i].x, r[i].y}
+	}
+}
+
+// Geocentric returns the geocentric ecliptic longitude, latitude and
+// distance of Jupiter as used by E5.
+//
+// Coordinates are corrected for light time.  Distance Δ is in AU.
+func Geocentric(jde float64, earth, jupiter *pp.V87Planet) (λ0, β0 unit.Angle, Δ float64) {
+	λ, β, Δ, _ := geocentric(jde, earth, jupiter)
+	return unit.Angle(λ), unit.Angle(β), Δ
+}
+
+// E5Geocentric computes positions of moons of Jupiter by theory E5 given
+// a position of Jupiter already computed.
+//
+// Arguments λ0, β0, Δ are as returned by Geocentric.  Computing them is the
+// major cost of E5; when positions of the moons are wanted at short
+// intervals, as for animation, they may be computed once for a number of
+// frames, or interpolated from values computed at wider intervals.
+//
+// Results are as for E5.  With λ0, β0, Δ computed by Geocentric for the
+// same jde, results are identical to those of E5.
+func E5Geocentric(jde float64, λ0, β0 unit.Angle, Δ float64, pos *[4]XY) {
+	var r [4]xyz
+	e5(jde, jde-base.LightTime(Δ), λ0.Rad(), β0.Rad(), Δ, &r)
+	for i := range r {
+		pos[i] = XY{r[i].x, r[i].y}
+	}
+}
+
+// geocentric returns the geocentric ecliptic longitude and latitude and
+// the distance of Jupiter, corrected for light time τ.
+func geocentric(jde float64, earth, jupiter *pp.V87Planet) (λ0, β0, Δ, τ float64) {
+	Δ = 5
+	s, β, R := solar.TrueVSOP87(earth, jde)
+	ss, cs := math.Sincos(s.Rad())
+	sβ := math.Sin(β.Rad())
+	τ = base.LightTime(Δ)
+	var x, y, z float64
+	f := func() {
+		l, b, r := jupiter.Position(jde - τ)
+		sl, cl := math.Sincos(l.Rad())
+		sb, cb := math.Sincos(b.Rad())
+		x = r*cb*cl + R*cs
+		y = r*cb*sl + R*ss
+		z = r*sb + R*sβ
+		Δ = math.Sqrt(x*x + y*y + z*z)
+		τ = base.LightTime(Δ)
 	}
+	f()
+	f()
+	λ0 = math.Atan2(y, x)
+	β0 = math.Atan(z / math.Hypot(x, y))
+	return
+}
+
+// xyz holds coordinates of a moon as computed by e5.
+//
+// x, y are as returned by E5, z is distance along the line of sight,
+// positive for a moon farther from the observer than Jupiter.
+type xyz struct {
+	x, y, z float64
+}
+
+// e5 computes positions of moons by theory E5 as seen from a direction
+// λ0, β0 at distance Δ AU.
+//
+// Argument jt is the time, corrected for light time, for which positions
+// of the moons are computed.
+func e5(jde, jt, λ0, β0, Δ float64, pos *[4]xyz) {
+	t := jt - 2443000.5
 	const p = math.Pi / 180
 	l1 := 106.07719*p + 203.48895579*p*t
 	l2 := 175.73161*p + 101.374724735*p*t
@@ -375,7 +428,7 @@ func E5(jde float64, earth, jupiter *pp.V87Planet, pos *[4]XY) {
 				.0000048*math.Cos(2*(l4-ω4))),
 		}
 		// p. 311
-		T0 := (jde - 2433282.423) / base.JulianCentury
+		T0 := (jde - base.B1950) / base.JulianCentury
 		P := (1.3966626*p + .0003088*p*T0) * T0
 		for i := range L {
 			L[i] += P
@@ -440,10 +493,201 @@ func E5(jde float64, earth, jupiter *pp.V87Planet, pos *[4]XY) {
 		x += math.Abs(z) / k[i] * math.Sqrt(1-d*d)
 		// perspective effect
 		W := Δ / (Δ + z/2095)
-		pos[i].X = x * W
-		pos[i].Y = y * W
+		pos[i] = xyz{x * W, y * W, z}
 	}
-	return
 }
 
 var k = [...]float64{17295, 21819, 27558, 36548}
+
+// Kinds of mutual events.
+const (
+	Occultation = iota // one moon passes in front of another
+	Eclipse            // one moon passes through the shadow of another
+)
+
+// MutualEvent describes an occultation or eclipse of one Galilean moon by
+// another.
+//
+// Moons are identified by index 0-3 for I-IV, as with E5.
+type MutualEvent struct {
+	Kind    int     // Occultation or Eclipse
+	Active  int     // the occulting or eclipsing moon
+	Passive int     // the occulted or eclipsed moon
+	Start   float64 // jde of first contact
+	Max     float64 // jde of maximum
+	End     float64 // jde of last contact
+	Sep     float64 // minimum separation of centers, in Jupiter radii
+	Drop    float64 // estimated magnitude drop of the two moons combined
+}
+
+// Radii of the Galilean moons in units of the equatorial radius of
+// Jupiter, and magnitudes at mean opposition.
+var (
+	moonRadius = [4]float64{1821.6 / 71492, 1560.8 / 71492,
+		2631.2 / 71492, 2410.3 / 71492}
+	moonMag = [4]float64{5.0, 5.3, 4.6, 5.6}
+)
+
+// MutualEvents finds mutual occultations and eclipses of the Galilean moons.
+//
+// Events with maximum in the range [jde1, jde2) are returned, ordered by
+// time of maximum.  Positions are computed with E5 as seen from the Earth
+// for occultations and as seen from the Sun for eclipses.  The shadow of
+// a moon is taken to be the cone of its penumbra.  Contacts are those of
+// the disk of the passive moon with the disk or penumbra of the active moon.
+//
+// Drop is an estimate that takes the obscured fraction of the passive moon
+// to be the fraction of its disk overlapped by the disk of the active moon,
+// or for eclipses, by a disk of the same radius centered on the shadow.
+// Limb darkening and the penumbral gradient are ignored.
+//
+// Mutual events occur only in the months around the times when the Earth
+// and the Sun pass through the equatorial plane of Jupiter.  At other times
+// the result will typically be empty.
+func MutualEvents(jde1, jde2 float64, earth, jupiter *pp.V87Planet) []MutualEvent {
+	// Positions are sampled at this interval.  Minima of separation
+	// of any pair are much farther apart than this.
+	const step = 1. / 24
+	var events []MutualEvent
+	m := func(jde float64) *mutual { return newMutual(jde, earth, jupiter) }
+	m0, m1 := m(jde1-step), m(jde1)
+	for j1 := jde1; j1 < jde2; j1 += step {
+		m2 := m(j1 + step)
+		for kind := Occultation; kind <= Eclipse; kind++ {
+			for a := 0; a < 4; a++ {
+				for p := a + 1; p < 4; p++ {
+					g1 := m1.g(kind, a, p)
+					if g1 > m0.g(kind, a, p) || g1 >= m2.g(kind, a, p) {
+						continue // not a minimum
+					}
+					g := func(jde float64) float64 {
+						return m(jde).g(kind, a, p)
+					}
+					if e, ok := mutualEvent(kind, a, p, g, m,
+						j1-step, j1+step); ok &&
+						e.Max >= jde1 && e.Max < jde2 {
+						events = append(events, e)
+					}
+				}
+			}
+		}
+		m0, m1 = m1, m2
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Max < events[j].Max
+	})
+	return events
+}
+
+// mutual holds positions of the moons at an instant as seen from the Earth
+// and from the Sun.
+type mutual struct {
+	view [2][4]xyz // indexed by Occultation, Eclipse
+	θ    float64   // angular radius of the Sun seen from Jupiter
+}
+
+func newMutual(jde float64, earth, jupiter *pp.V87Planet) *mutual {
+	m := &mutual{}
+	λ0, β0, Δ, τ := geocentric(jde, earth, jupiter)
+	e5(jde, jde-τ, λ0, β0, Δ, &m.view[Occultation])
+	l, b, r := jupiter.Position(jde - τ)
+	e5(jde, jde-τ, l.Rad(), b.Rad(), r, &m.view[Eclipse])
+	m.θ = 695700 / (r * base.AU)
+	return m
+}
+
+// g returns separation of moons a and p less the separation at contact.
+func (m *mutual) g(kind, a, p int) float64 {
+	s, lim, _, _ := m.sep(kind, a, p)
+	return s - lim
+}
+
+// sep returns separation of moons a and p, the separation at contact,
+// and the z coordinates of the two moons.
+func (m *mutual) sep(kind, a, p int) (s, lim, za, zp float64) {
+	ma, mp := m.view[kind][a], m.view[kind][p]
+	lim = moonRadius[a] + moonRadius[p]
+	if kind == Eclipse {
+		// the penumbra grows with distance behind the eclipsing moon
+		lim += math.Abs(mp.z-ma.z) * m.θ
+	}
+	return math.Hypot(mp.x-ma.x, mp.y-ma.y), lim, ma.z, mp.z
+}
+
+// mutualEvent refines a minimum of separation bracketed by [j0, j2].
+//
+// The minimum is found by golden section search, contacts by bisection.
+// Result ok is false if the minimum is not an event.
+func mutualEvent(kind, a, p int, g func(float64) float64, m func(float64) *mutual, j0, j2 float64) (e MutualEvent, ok bool) {
+	const r = .381966011250105
+	lo, hi := j0, j2
+	x1 := lo + r*(hi-lo)
+	x2 := hi - r*(hi-lo)
+	f1, f2 := g(x1), g(x2)
+	for hi-lo > 1e-6 {
+		if f1 < f2 {
+			hi, x2, f2 = x2, x1, f1
+			x1 = lo + r*(hi-lo)
+			f1 = g(x1)
+		} else {
+			lo, x1, f1 = x1, x2, f2
+			x2 = hi - r*(hi-lo)
+			f2 = g(x2)
+		}
+	}
+	jm := (lo + hi) / 2
+	s, lim, za, zp := m(jm).sep(kind, a, p)
+	if s >= lim {
+		return
+	}
+	e = MutualEvent{Kind: kind, Active: a, Passive: p, Max: jm, Sep: s}
+	if zp < za {
+		e.Active, e.Passive = p, a
+	}
+	e.Start = contact(g, j0, jm)
+	e.End = contact(g, j2, jm)
+	e.Drop = drop(e.Active, e.Passive, s)
+	return e, true
+}
+
+// contact finds by bisection the time between out and in where g crosses
+// zero.  g(in) must be negative.  If g(out) is not positive, the search is
+// extended beyond out.
+func contact(g func(float64) float64, out, in float64) float64 {
+	for g(out) <= 0 {
+		out, in = out+(out-in), out
+	}
+	for math.Abs(out-in) > 1e-6 {
+		m := (out + in) / 2
+		if g(m) > 0 {
+			out = m
+		} else {
+			in = m
+		}
+	}
+	return (out + in) / 2
+}
+
+// drop estimates the magnitude drop of a mutual event.
+func drop(a, p int, s float64) float64 {
+	ra, rp := moonRadius[a], moonRadius[p]
+	f := overlap(ra, rp, s) / (math.Pi * rp * rp)
+	la := math.Pow(10, -.4*moonMag[a])
+	lp := math.Pow(10, -.4*moonMag[p])
+	return 2.5 * math.Log10((la+lp)/(la+lp-f*lp))
+}
+
+// overlap returns the area of overlap of two circles of radii r1, r2 with
+// centers separated by d.
+func overlap(r1, r2, d float64) float64 {
+	switch {
+	case d >= r1+r2:
+		return 0
+	case d <= math.Abs(r1-r2):
+		r := math.Min(r1, r2)
+		return math.Pi * r * r
+	}
+	a1 := math.Acos((d*d + r1*r1 - r2*r2) / (2 * d * r1))
+	a2 := math.Acos((d*d + r2*r2 - r1*r1) / (2 * d * r2))
+	return r1*r1*(a1-math.Sin(2*a1)/2) + r2*r2*(a2-math.Sin(2*a2)/2)
+}
@@ -7,6 +7,7 @@ package jupitermoons_test
 
 import (
 	"fmt"
+	"testing"
 
 	"github.com/soniakeys/meeus/v3/deltat"
 	"github.com/soniakeys/meeus/v3/julian"
@@ -39,6 +40,32 @@ func ExampleE5() {
 	// Y  +0.2137  +0.2752  +0.5900  +1.0290
 }
 
+func ExampleE5Geocentric() {
+	// Example 44.b, p. 314, with the position of Jupiter computed
+	// separately.
+	e, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	j, err := pp.LoadPlanet(pp.Jupiter)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	jde := 2448972.50068
+	λ0, β0, Δ := jupitermoons.Geocentric(jde, e, j)
+	var pos [4]jupitermoons.XY
+	jupitermoons.E5Geocentric(jde, λ0, β0, Δ, &pos)
+	fmt.Printf("X  %+.4f  %+.4f  %+.4f  %+.4f\n",
+		pos[0].X, pos[1].X, pos[2].X, pos[3].X)
+	fmt.Printf("Y  %+.4f  %+.4f  %+.4f  %+.4f\n",
+		pos[0].Y, pos[1].Y, pos[2].Y, pos[3].Y)
+	// Output:
+	// X  -3.4503  +7.4418  +1.2010  +7.0720
+	// Y  +0.2137  +0.2752  +0.5900  +1.0290
+}
+
 // The exercise of finding the zero crossing is not coded here, but computed
 // are offsets at the times given by Meeus, showing the X coordinates near
 // zero (indicating conjunction) and Y coordinates near the values given by
@@ -70,3 +97,54 @@ func ExampleE5_conjunction() {
 	// III  7ʰ28ᵐ  X = +0.0032  Y = -0.8042
 	// IV   5ʰ15ᵐ  X = +0.0002  Y = +1.3990
 }
+
+// Mutual events around the Jovian equinox of 2021.
+func TestMutualEvents(t *testing.T) {
+	e, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		t.Skip(err)
+	}
+	j, err := pp.LoadPlanet(pp.Jupiter)
+	if err != nil {
+		t.Skip(err)
+	}
+	j1 := julian.CalendarGregorianToJD(2021, 6, 1)
+	j2 := j1 + 30
+	ev := jupitermoons.MutualEvents(j1, j2, e, j)
+	if len(ev) == 0 {
+		t.Fatal("no events")
+	}
+	for i, m := range ev {
+		if m.Max < j1 || m.Max >= j2 {
+			t.Errorf("event %d out of range: %+v", i, m)
+		}
+		if i > 0 && m.Max < ev[i-1].Max {
+			t.Errorf("event %d out of order", i)
+		}
+		if !(m.Start < m.Max && m.Max < m.End) || m.End-m.Start > .2 {
+			t.Errorf("event %d contacts: %+v", i, m)
+		}
+		if m.Active == m.Passive || m.Drop < 0 || m.Drop > 2 {
+			t.Errorf("event %d: %+v", i, m)
+		}
+	}
+}
+
+func TestE5Geocentric(t *testing.T) {
+	e, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		t.Skip(err)
+	}
+	j, err := pp.LoadPlanet(pp.Jupiter)
+	if err != nil {
+		t.Skip(err)
+	}
+	jde := 2448972.50068
+	var want, got [4]jupitermoons.XY
+	jupitermoons.E5(jde, e, j, &want)
+	λ0, β0, Δ := jupitermoons.Geocentric(jde, e, j)
+	jupitermoons.E5Geocentric(jde, λ0, β0, Δ, &got)
+	if got != want {
+		t.Fatal(got, want)
+	}
+}
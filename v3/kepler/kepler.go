@@ -5,6 +5,7 @@
 package kepler
 
 import (
+	"errors"
 	"math"
 
 	"github.com/soniakeys/meeus/v3/iterate"
@@ -116,6 +117,65 @@ func Kepler2b(e float64, M unit.Angle, places int) (E unit.Angle, err error) {
 	return unit.Angle(ea), err
 }
 
+// Options controls iteration in Kepler2bOpt.
+type Options struct {
+	Tolerance     float64 // absolute tolerance on E, in radians
+	MaxIterations int     // iteration limit
+}
+
+// DefaultOptions are reasonable options for Kepler2bOpt.
+var DefaultOptions = Options{
+	Tolerance:     1e-12,
+	MaxIterations: 100,
+}
+
+// Result holds a solution of Kepler's equation and the state of the
+// iteration that produced it.
+type Result struct {
+	E          unit.Angle // eccentric anomaly
+	Residual   float64    // |E - e sin E - M|, in radians
+	Iterations int        // number of iterations performed
+}
+
+// ErrorNoConvergence is returned by Kepler2bOpt when the iteration limit
+// is reached.
+var ErrorNoConvergence = errors.New("Maximum iterations reached")
+
+// Kepler2bOpt solves Kepler's equation by iteration.
+//
+// The iterated formula and limiting function are those of Kepler2b.
+// Iteration stops when a correction to E is smaller than opt.Tolerance.
+//
+// Argument e is eccentricity, M is mean anomaly.
+//
+// Result r holds eccentric anomaly E with the residual of Kepler's equation
+// and the number of iterations performed.  When the iteration limit is
+// reached, err is ErrorNoConvergence and r holds the last value of E.  The
+// residual can be used to detect marginal convergence, as for e close to 1.
+func Kepler2bOpt(e float64, M unit.Angle, opt Options) (r Result, err error) {
+	E := M.Rad()
+	err = ErrorNoConvergence
+	for r.Iterations < opt.MaxIterations {
+		r.Iterations++
+		se, ce := math.Sincos(E)
+		d := (M.Rad() + e*se - E) / (1 - e*ce)
+		// method of Steele, p. 205
+		if d > .5 {
+			d = .5
+		} else if d < -.5 {
+			d = -.5
+		}
+		E += d
+		if math.Abs(d) < opt.Tolerance {
+			err = nil
+			break
+		}
+	}
+	r.E = unit.Angle(E)
+	r.Residual = math.Abs(E - e*math.Sin(E) - M.Rad())
+	return
+}
+
 // Kepler3 solves Kepler's equation by binary search.
 //
 // Argument e is eccentricity, M is mean anomaly.
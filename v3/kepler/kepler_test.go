@@ -5,6 +5,7 @@ package kepler_test
 
 import (
 	"fmt"
+	"testing"
 
 	"github.com/soniakeys/meeus/v3/kepler"
 	"github.com/soniakeys/unit"
@@ -60,6 +61,44 @@ func ExampleKepler2b() {
 	// 1.066997365282
 }
 
+func ExampleKepler2bOpt() {
+	// Example data from p. 205
+	r, err := kepler.Kepler2bOpt(.99, .2, kepler.DefaultOptions)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.12f\n", r.E)
+	fmt.Println(r.Iterations, "iterations")
+	fmt.Println("residual < 1e-15:", r.Residual < 1e-15)
+	// Output:
+	// 1.066997365282
+	// 7 iterations
+	// residual < 1e-15: true
+}
+
+func TestKepler2bOpt(t *testing.T) {
+	// too few iterations
+	r, err := kepler.Kepler2bOpt(.99, .2, kepler.Options{Tolerance: 1e-12, MaxIterations: 3})
+	if err != kepler.ErrorNoConvergence {
+		t.Fatal("expected ErrorNoConvergence, got", err)
+	}
+	if r.Iterations != 3 || r.Residual < 1e-6 {
+		t.Fatal(r)
+	}
+	for _, e := range []float64{0, .1, .5, .9, .99, .999999} {
+		for M := unit.Angle(-3); M < 3; M += .25 {
+			r, err := kepler.Kepler2bOpt(e, M, kepler.DefaultOptions)
+			if err != nil {
+				t.Fatal(e, M, err)
+			}
+			if r.Residual > 1e-12 {
+				t.Error(e, M, r)
+			}
+		}
+	}
+}
+
 func ExampleKepler3() {
 	// Example data from p. 205
 	fmt.Printf("%.12f\n", kepler.Kepler3(.99, .2))
@@ -39,6 +39,56 @@ func Time(r1, d1, r2, d2 unit.Angle, r3, d3 []unit.Angle, t1, t5 float64) (float
 	return l5.Zero(false)
 }
 
+// ErrorNoMinimum is returned by MinDeviation when the deviation has no
+// minimum within the ephemeris.
+var ErrorNoMinimum = errors.New("no minimum deviation within ephemeris")
+
+// MinDeviation computes the time at which three moving bodies come closest
+// to lying on a straight line (great circle).
+//
+// Arguments r1, d1, r2, d2, r3, d3 are ephemerides of 5 rows for the three
+// bodies, all starting at time t1 and ending at time t5.  The deviation is
+// the angular distance of the third body from the line defined by the first
+// two, as computed by the function Error.
+//
+// Results are the time of minimum deviation and the deviation at that time.
+// If the bodies pass through alignment, the result is the time of the zero
+// of the deviation.  Otherwise the time is found as the extremum of the
+// deviation.
+func MinDeviation(r1, d1, r2, d2, r3, d3 []unit.Angle, t1, t5 float64) (t float64, ω unit.Angle, err error) {
+	for _, s := range [][]unit.Angle{r1, d1, r2, d2, r3, d3} {
+		if len(s) != 5 {
+			return 0, 0, errors.New("ephemerides must be length 5")
+		}
+	}
+	e := make([]float64, 5)
+	cross := false
+	for i := range e {
+		e[i] = Error(r1[i], d1[i], r2[i], d2[i], r3[i], d3[i]).Rad()
+		if i > 0 && (e[i-1] < 0) != (e[i] < 0) {
+			cross = true
+		}
+	}
+	l5, err := interp.NewLen5(t1, t5, e)
+	if err != nil {
+		return 0, 0, err
+	}
+	if cross {
+		if t, err = l5.Zero(true); err != nil {
+			return 0, 0, err
+		}
+		return t, unit.Angle(l5.InterpolateX(t)), nil
+	}
+	t, y, err := l5.Extremum()
+	if err != nil {
+		return 0, 0, err
+	}
+	if math.Abs(y) > math.Abs(e[0]) || math.Abs(y) > math.Abs(e[4]) {
+		return 0, 0, ErrorNoMinimum
+	}
+	return t, unit.Angle(y), nil
+}
+
 // Angle returns the angle between great circles defined by three points.
 //
 // Coordinates may be right ascensions and declinations or longitudes and
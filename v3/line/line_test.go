@@ -6,6 +6,7 @@ package line_test
 import (
 	"fmt"
 	"math"
+	"testing"
 	"time"
 
 	"github.com/soniakeys/meeus/v3/julian"
@@ -48,6 +49,64 @@ func ExampleTime() {
 	// 1994 October 1, at 5ʰ TD(UT)
 }
 
+func ExampleMinDeviation() {
+	// Data of example 19.a, p. 121, with Castor and Pollux held fixed.
+	// Mars passes through alignment.
+	fix := func(a float64) []unit.Angle {
+		s := make([]unit.Angle, 5)
+		for i := range s {
+			s[i] = unit.AngleFromDeg(a)
+		}
+		return s
+	}
+	r3 := make([]unit.Angle, 5)
+	for i, ri := range []float64{
+		118.98067, 119.59396, 120.20413, 120.81108, 121.41475} {
+		r3[i] = unit.AngleFromDeg(ri)
+	}
+	d3 := make([]unit.Angle, 5)
+	for i, di := range []float64{
+		21.68417, 21.58983, 21.49394, 21.39653, 21.29761} {
+		d3[i] = unit.AngleFromDeg(di)
+	}
+	jd, ω, err := line.MinDeviation(
+		fix(113.56833), fix(31.89756), fix(116.25042), fix(28.03681), r3, d3,
+		julian.CalendarGregorianToJD(1994, 9, 29),
+		julian.CalendarGregorianToJD(1994, 10, 3))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, m, d := julian.JDToCalendar(jd)
+	fmt.Printf("%d %s %.4f  ω = %.1f″\n", y, time.Month(m), d, ω.Sec())
+	// Output:
+	// 1994 October 1.2233  ω = 0.0″
+}
+
+func TestMinDeviation(t *testing.T) {
+	// third body approaching and receding from a line on the equator.
+	z := make([]unit.Angle, 5)
+	r2 := make([]unit.Angle, 5)
+	r3 := make([]unit.Angle, 5)
+	d3 := make([]unit.Angle, 5)
+	for i := range z {
+		x := float64(i) - 1.7
+		r2[i] = unit.AngleFromDeg(10)
+		r3[i] = unit.AngleFromDeg(20 + x)
+		d3[i] = unit.AngleFromDeg(.1 + .01*x*x)
+	}
+	tm, ω, err := line.MinDeviation(z, z, r2, z, r3, d3, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(tm-1.7) > 1e-3 {
+		t.Fatal("time", tm)
+	}
+	if math.Abs(ω.Deg()-.1) > 1e-5 {
+		t.Fatal("ω", ω.Deg())
+	}
+}
+
 func ExampleAngle() {
 	// Example p. 123.
 	rδ := unit.NewRA(5, 32, 0.40).Angle()
@@ -7,11 +7,15 @@ package mars
 import (
 	"math"
 
+	"github.com/soniakeys/meeus/v3/angle"
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/illum"
+	"github.com/soniakeys/meeus/v3/kepler"
 	"github.com/soniakeys/meeus/v3/nutation"
+	pe "github.com/soniakeys/meeus/v3/planetelements"
 	pp "github.com/soniakeys/meeus/v3/planetposition"
+	"github.com/soniakeys/meeus/v3/solar"
 	"github.com/soniakeys/unit"
 )
 
@@ -73,7 +77,7 @@ func Physical(jde float64, earth, mars *pp.V87Planet) (DE, DS, ω, P, Q, d, q un
 	sbʹ, cbʹ := math.Sincos(bʹ)
 	DS = unit.Angle(math.Asin(-sβ0*sbʹ - cβ0*cbʹ*math.Cos(λ0-lʹ)))
 	// Step 9.
-	W := 11.504*p + 350.89200025*p*(jde-τ-2433282.5)
+	W := 11.504*p + 350.89200025*p*(jde-τ-base.J1950)
 	// Step 10.
 	ε0 := nutation.MeanObliquity(jde)
 	sε0, cε0 := ε0.Sincos()
@@ -104,14 +108,10 @@ func Physical(jde float64, earth, mars *pp.V87Planet) (DE, DS, ω, P, Q, d, q un
 	α0ʹ, δ0ʹ := coord.EclToEq(unit.Angle(λ0), unit.Angle(β0), sε, cε)
 	αʹ, δʹ := coord.EclToEq(unit.Angle(λ), unit.Angle(β), sε, cε)
 	// Step 17.
-	sδ0ʹ, cδ0ʹ := δ0ʹ.Sincos()
-	sδʹ, cδʹ := δʹ.Sincos()
-	sα0ʹαʹ, cα0ʹαʹ := (α0ʹ - αʹ).Sincos()
 	// (42.4) p. 290
-	P = unit.Angle(math.Atan2(cδ0ʹ*sα0ʹαʹ, sδ0ʹ*cδʹ-cδ0ʹ*sδʹ*cα0ʹαʹ))
-	if P < 0 {
-		P += 2 * math.Pi
-	}
+	P = angle.PositionAngle(
+		&coord.Equatorial{RA: αʹ, Dec: δʹ},
+		&coord.Equatorial{RA: α0ʹ, Dec: δ0ʹ})
 	// Step 18.
 	s := l0 + math.Pi
 	ss, cs := s.Sincos()
@@ -127,3 +127,84 @@ func Physical(jde float64, earth, mars *pp.V87Planet) (DE, DS, ω, P, Q, d, q un
 	q = d.Mul(1 - k)
 	return
 }
+
+// XY used for returning coordinates of moons.
+type XY struct {
+	X, Y float64 // in units of Mars equatorial radii
+}
+
+// mean orbits of Phobos and Deimos, approximated as circular and in the
+// plane of Mars' equator.
+var (
+	// semimajor axis in Mars radii, mean longitude at J2000 and mean motion
+	// in degrees per day.  Longitudes are measured in the plane of Mars'
+	// equator from its ascending node on the Earth's equator.
+	phobos = struct{ a, L0, n float64 }{2.7608, 88.900, 1128.8447569}
+	deimos = struct{ a, L0, n float64 }{6.9071, 250.583, 285.1618790}
+)
+
+// Moons computes approximate positions of Phobos and Deimos.
+//
+// Returned coordinates are apparent positions relative to the center of
+// Mars, in units of the equatorial radius of Mars, in a system analogous
+// to that of jupitermoons.Positions.  X is measured along Mars' equator,
+// Y is measured toward Mars' north pole.  A satellite with |X| near its
+// maximum is near greatest elongation.
+//
+// Orbits are approximated as circular and in the plane of Mars' equator,
+// with mean motions from modern orbital elements.  The position of Mars is
+// computed from the mean orbital elements of package planetelements and
+// that of the Earth from the solar position of chapter 25.  Results are
+// approximate, suitable for planning observations.
+func Moons(jde float64) (pPhobos, pDeimos XY) {
+	// Earth from the Sun, ch. 25
+	T := base.J2000Century(jde)
+	s, _ := solar.True(T)
+	R := solar.Radius(T)
+	ss, cs := s.Sincos()
+	x0, y0, z0 := -R*cs, -R*ss, 0.
+	// geocentric Mars, with light time
+	var m pe.Elements
+	var x, y, z, τ float64
+	for i := 0; i < 2; i++ {
+		pe.Mean(pe.Mars, jde-τ, &m)
+		x, y, z = heliocentric(&m)
+		x -= x0
+		y -= y0
+		z -= z0
+		τ = base.LightTime(math.Sqrt(x*x + y*y + z*z))
+	}
+	// to equatorial
+	sε, cε := nutation.MeanObliquity(jde).Sincos()
+	y, z = y*cε-z*sε, y*sε+z*cε
+	// Mars pole, (42.1) p. 288
+	α0, δ0 := coord.EclToEq(unit.AngleFromDeg(352.9065+1.1733*T),
+		unit.AngleFromDeg(63.2818-.00394*T), sε, cε)
+	sα0, cα0 := α0.Sincos()
+	sδ0, cδ0 := δ0.Sincos()
+	// components of geocentric Mars along the ascending node of Mars'
+	// equator, the direction 90° from that along the equator, and the pole.
+	dx := -x*sα0 + y*cα0
+	dy := -x*sδ0*cα0 - y*sδ0*sα0 + z*cδ0
+	dz := x*cδ0*cα0 + y*cδ0*sα0 + z*sδ0
+	λd := math.Atan2(dy, dx)
+	sDE := -dz / math.Sqrt(x*x+y*y+z*z)
+	const p = math.Pi / 180
+	d := jde - τ - base.J2000
+	xy := func(L0, n, a float64) XY {
+		su, cu := math.Sincos(L0*p + n*p*d - λd)
+		return XY{a * su, -a * cu * sDE}
+	}
+	return xy(phobos.L0, phobos.n, phobos.a), xy(deimos.L0, deimos.n, deimos.a)
+}
+
+// heliocentric returns rectangular coordinates for mean elements e.
+func heliocentric(e *pe.Elements) (x, y, z float64) {
+	E := kepler.Kepler3(e.Ecc, e.Lon-e.Peri)
+	ν := kepler.True(E, e.Ecc)
+	r := kepler.Radius(E, e.Ecc, e.Axis)
+	su, cu := (ν + e.Peri - e.Node).Sincos()
+	sΩ, cΩ := e.Node.Sincos()
+	si, ci := e.Inc.Sincos()
+	return r * (cΩ*cu - sΩ*su*ci), r * (sΩ*cu + cΩ*su*ci), r * su * si
+}
@@ -0,0 +1,70 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package mars_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/soniakeys/meeus/v3/mars"
+)
+
+func ExampleMoons() {
+	// Date of example 42.a, p. 291
+	p, d := mars.Moons(2448935.500683)
+	fmt.Printf("Phobos:  X = %+.2f  Y = %+.2f\n", p.X, p.Y)
+	fmt.Printf("Deimos:  X = %+.2f  Y = %+.2f\n", d.X, d.Y)
+	// Output:
+	// Phobos:  X = +0.35  Y = +0.59
+	// Deimos:  X = +1.37  Y = +1.46
+}
+
+func TestMoons(t *testing.T) {
+	// Through one orbit of Deimos from the date of example 42.a, p. 291.
+	// Greatest elongations are the orbit radii, 9376 km and 23463 km in
+	// units of the Mars equatorial radius 3396 km.  The ratio of the minor
+	// to major axes of the apparent orbits is sin DE, with DE = 12.44°
+	// from the example.  Successive greatest eastern elongations are
+	// separated by the sidereal periods, 0.31891 and 1.26244 days, nearly.
+	const j0, step = 2448935.5, .0001
+	var maxX, maxY [2]float64
+	var last [2]float64
+	var prev [2]mars.XY
+	var rising [2]bool
+	prev[0], prev[1] = mars.Moons(j0)
+	for j := j0 + step; j < j0+3; j += step {
+		p, d := mars.Moons(j)
+		for i, m := range []mars.XY{p, d} {
+			if j < j0+1.3 {
+				maxX[i] = math.Max(maxX[i], math.Abs(m.X))
+				maxY[i] = math.Max(maxY[i], math.Abs(m.Y))
+			}
+			r := m.X > prev[i].X
+			if !r && rising[i] && prev[i].X > 0 {
+				if last[i] > 0 {
+					period := j - step - last[i]
+					want := [2]float64{.31891, 1.26244}[i]
+					if math.Abs(period-want) > .002 {
+						t.Errorf("moon %d: period %.5f, want %.5f", i, period, want)
+					}
+				}
+				last[i] = j - step
+			}
+			rising[i], prev[i] = r, m
+		}
+	}
+	if math.Abs(maxX[0]-2.761) > .005 || math.Abs(maxX[1]-6.909) > .005 {
+		t.Errorf("greatest elongations %.3f, %.3f", maxX[0], maxX[1])
+	}
+	sDE := math.Sin(12.44 * math.Pi / 180)
+	for i := range maxX {
+		if r := maxY[i] / maxX[i]; math.Abs(r-sDE) > .002 {
+			t.Errorf("moon %d: axis ratio %.4f, want sin DE %.4f", i, r, sDE)
+		}
+	}
+	if last[0] == 0 || last[1] == 0 {
+		t.Error("no greatest elongations found")
+	}
+}
@@ -9,6 +9,7 @@ package moon
 import (
 	"math"
 
+	"github.com/soniakeys/meeus/v3/angle"
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/moonposition"
@@ -41,6 +42,101 @@ func Physical(jde float64, earth *pp.V87Planet) (l, b, P, l0, b0 unit.Angle) {
 	return
 }
 
+// SubEarth returns the selenographic coordinates of the sub-Earth point,
+// the point on the Moon where the Earth is at the zenith.
+//
+// These are the librations l, b returned by Physical, and are the
+// selenographic coordinates of the center of the apparent disk of the Moon.
+func SubEarth(jde float64) (l, b unit.Angle) {
+	λ, β, _ := moonposition.Position(jde)
+	return newMoon(jde).lib(λ, β)
+}
+
+// SubSolar returns the selenographic coordinates of the subsolar point,
+// the point on the Moon where the Sun is at the zenith.
+//
+// These are the coordinates l0, b0 returned by Physical.
+func SubSolar(jde float64, earth *pp.V87Planet) (l0, b0 unit.Angle) {
+	λ, β, Δ := moonposition.Position(jde)
+	return newMoon(jde).sun(λ, β, Δ, earth)
+}
+
+// Colongitude returns the selenographic colongitude of the Sun, the
+// selenographic longitude of the morning terminator.
+//
+// It is 90° less the longitude of the subsolar point, in the range [0, 2π).
+// Colongitude is near 270° at New Moon, 0° at First Quarter, 90° at Full
+// Moon, and 180° at Last Quarter.
+func Colongitude(jde float64, earth *pp.V87Planet) unit.Angle {
+	l0, _ := SubSolar(jde, earth)
+	return (math.Pi/2 - l0).Mod1()
+}
+
+// BrightLimb returns the position angle of the midpoint of the bright limb
+// of the Moon, measured from north through east.
+//
+// Apparent positions of the Moon and Sun are those of package moonposition
+// and of solar.ApparentEquatorialVSOP87.  See angle.PositionAngle.
+func BrightLimb(jde float64, earth *pp.V87Planet) unit.Angle {
+	λ, β, _ := moonposition.Position(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	sε, cε := (nutation.MeanObliquity(jde) + Δε).Sincos()
+	α, δ := coord.EclToEq(λ+Δψ, β, sε, cε)
+	α0, δ0, _ := solar.ApparentEquatorialVSOP87(earth, jde)
+	return angle.PositionAngle(&coord.Equatorial{RA: α, Dec: δ},
+		&coord.Equatorial{RA: α0, Dec: δ0})
+}
+
+// SelenographicToEcliptic returns the geocentric ecliptic direction of the
+// outward normal to the lunar surface at a site on the Moon.
+//
+// Arguments η, θ are selenographic longitude and latitude of the site.
+// Results λ, β are referred to the mean equinox of date, without nutation,
+// as are the coordinates of moonposition.Position.
+//
+// This is the inverse of the transformation giving librations, including
+// physical librations.
+func SelenographicToEcliptic(jde float64, η, θ unit.Angle) (λ, β unit.Angle) {
+	m := newMoon(jde)
+	// selenographic unit vector of direction d, as lib gives selenographic
+	// coordinates of the direction opposite its argument.
+	sel := func(d [3]float64) (v [3]float64) {
+		λ := unit.Angle(math.Atan2(d[1], d[0]))
+		β := unit.Angle(math.Asin(d[2]))
+		l, b := m.lib(λ+math.Pi, -β)
+		sl, cl := l.Sincos()
+		sb, cb := b.Sincos()
+		return [3]float64{cb * cl, cb * sl, sb}
+	}
+	// The transformation is nearly a rotation.  Approximate it as a matrix
+	// and refine the inverse iteratively.
+	var a [3][3]float64 // rows are images of ecliptic basis vectors
+	for i := range a {
+		var e [3]float64
+		e[i] = 1
+		a[i] = sel(e)
+	}
+	sη, cη := η.Sincos()
+	sθ, cθ := θ.Sincos()
+	t := [3]float64{cθ * cη, cθ * sη, sθ}
+	var d [3]float64
+	for i := range d {
+		d[i] = a[i][0]*t[0] + a[i][1]*t[1] + a[i][2]*t[2]
+	}
+	for n := 0; n < 4; n++ {
+		v := sel(d)
+		r := [3]float64{t[0] - v[0], t[1] - v[1], t[2] - v[2]}
+		for i := range d {
+			d[i] += a[i][0]*r[0] + a[i][1]*r[1] + a[i][2]*r[2]
+		}
+		h := math.Sqrt(d[0]*d[0] + d[1]*d[1] + d[2]*d[2])
+		d[0], d[1], d[2] = d[0]/h, d[1]/h, d[2]/h
+	}
+	λ = unit.Angle(math.Atan2(d[1], d[0])).Mod1()
+	β = unit.Angle(math.Asin(d[2]))
+	return
+}
+
 // Quantities computed for a jde and used in computing return values of
 // Physical().  Computations are broken into several methods to organize
 // the code.
@@ -59,20 +155,14 @@ func newMoon(jde float64) *moon {
 	var Δε unit.Angle
 	m.Δψ, Δε = nutation.Nutation(jde)
 	T := base.J2000Century(jde)
-	m.F = unit.AngleFromDeg(base.Horner(T,
-		93.272095, 483202.0175233, -.0036539, -1/3526000, 1/863310000))
-	F := m.F.Rad()
+	dA, mA, mʹA, fA := moonposition.Arguments(jde)
+	m.F = fA
+	D, M, Mʹ, F := dA.Rad(), mA.Rad(), mʹA.Rad(), fA.Rad()
 	m.Ω = unit.AngleFromDeg(base.Horner(T,
 		125.0445479, -1934.1362891, .0020754, 1/467441, -1/60616000))
 	// true ecliptic
 	m.sε, m.cε = math.Sincos((nutation.MeanObliquity(jde) + Δε).Rad())
 	// ρ, σ, τ, p. 372,373
-	D := unit.AngleFromDeg(base.Horner(T,
-		297.8501921, 445267.1114034, -.0018819, 1/545868, -1/113065000)).Rad()
-	M := unit.AngleFromDeg(base.Horner(T,
-		357.5291092, 35999.0502909, -.0001535, 1/24490000)).Rad()
-	Mʹ := unit.AngleFromDeg(base.Horner(T,
-		134.9633964, 477198.8675055, .0087414, 1/69699, -1/14712000)).Rad()
 	E := base.Horner(T, 1, -.002516, -.0000074)
 	K1 := unit.AngleFromDeg(119.75 + 131.849*T).Rad()
 	K2 := unit.AngleFromDeg(72.56 + 20.186*T).Rad()
@@ -0,0 +1,38 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package moon_test
+
+import (
+	"fmt"
+
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/moon"
+	"github.com/soniakeys/unit"
+)
+
+func ExampleSelenographicToEcliptic() {
+	// The librations of example 53.a, p. 376, give the selenographic
+	// coordinates of the point on the Moon facing the Earth.  The normal
+	// there points toward the Earth, opposite the direction of the Moon
+	// of example 47.a, p. 342, λ = 133.16°, β = -3.23°.
+	j := julian.CalendarGregorianToJD(1992, 4, 12)
+	λ, β := moon.SelenographicToEcliptic(j,
+		unit.AngleFromDeg(-1.23), unit.AngleFromDeg(4.20))
+	fmt.Printf("λ = %.2f°\n", λ.Deg())
+	fmt.Printf("β = %+.2f°\n", β.Deg())
+	// Output:
+	// λ = 313.16°
+	// β = +3.23°
+}
+
+func ExampleSubEarth() {
+	// Example 53.a, p. 376.
+	j := julian.CalendarGregorianToJD(1992, 4, 12)
+	l, b := moon.SubEarth(j)
+	fmt.Printf("l = %.2f\n", l.Deg())
+	fmt.Printf("b = %+.2f\n", b.Deg())
+	// Output:
+	// l = -1.23
+	// b = +4.20
+}
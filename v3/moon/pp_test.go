@@ -36,6 +36,37 @@ func ExamplePhysical() {
 	// b0 = +1.46
 }
 
+func ExampleSubSolar() {
+	// Example 53.a, p. 376.
+	j := julian.CalendarGregorianToJD(1992, 4, 12)
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	l0, b0 := moon.SubSolar(j, earth)
+	fmt.Printf("l0 = %.2f\n", l0.Deg())
+	fmt.Printf("b0 = %+.2f\n", b0.Deg())
+	fmt.Printf("colongitude = %.2f\n", moon.Colongitude(j, earth).Deg())
+	// Output:
+	// l0 = 67.90
+	// b0 = +1.46
+	// colongitude = 22.10
+}
+
+func ExampleBrightLimb() {
+	// Example 48.a, p. 347.
+	j := julian.CalendarGregorianToJD(1992, 4, 12)
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("χ = %.1f\n", moon.BrightLimb(j, earth).Deg())
+	// Output:
+	// χ = 285.0
+}
+
 func ExampleSunAltitude() {
 	j := julian.CalendarGregorianToJD(1992, 4, 12)
 	earth, err := pp.LoadPlanet(pp.Earth)
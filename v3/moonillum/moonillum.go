@@ -5,13 +5,18 @@
 //
 // Also see functions Illuminated and Limb in package base.  The function
 // for computing illuminated fraction given a phase angle (48.1) is
-// base.Illuminated.  Formula (48.5) is implemented as base.Limb.
+// base.Illuminated.  Formula (48.5) is angle.PositionAngle of the Moon
+// toward the Sun, which defines the position angle convention for the
+// library.  Its formula is that of base.Limb.
 package moonillum
 
 import (
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/interp"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/solar"
 	"github.com/soniakeys/unit"
 )
 
@@ -79,18 +84,110 @@ func PhaseAngleEcl2(λ, β, λ0 unit.Angle) unit.Angle {
 //
 // Less accurate than PhaseAngle functions taking coordinates.
 func PhaseAngle3(jde float64) unit.Angle {
+	D, M, Mʹ, _ := moonposition.Arguments(jde)
+	return math.Pi - D + unit.AngleFromDeg(
+		-6.289*Mʹ.Sin()+
+			2.1*M.Sin()+
+			-1.274*(2*D-Mʹ).Sin()+
+			-.658*(2*D).Sin()+
+			-.214*(2*Mʹ).Sin()+
+			-.11*D.Sin())
+}
+
+// eclipticPositions returns ecliptic coordinates of the Moon and Sun
+// as used in Example 48.a, with distances in km.
+func eclipticPositions(jde float64) (λ, β unit.Angle, Δ float64, λ0 unit.Angle, R float64) {
+	λ, β, Δ = moonposition.Position(jde)
 	T := base.J2000Century(jde)
-	D := unit.AngleFromDeg(base.Horner(T, 297.8501921,
-		445267.1114034, -.0018819, 1/545868, -1/113065000)).Mod1().Rad()
-	M := unit.AngleFromDeg(base.Horner(T,
-		357.5291092, 35999.0502909, -.0001535, 1/24490000)).Mod1().Rad()
-	Mʹ := unit.AngleFromDeg(base.Horner(T, 134.9633964,
-		477198.8675055, .0087414, 1/69699, -1/14712000)).Mod1().Rad()
-	return math.Pi - unit.Angle(D) + unit.AngleFromDeg(
-		-6.289*math.Sin(Mʹ)+
-			2.1*math.Sin(M)+
-			-1.274*math.Sin(2*D-Mʹ)+
-			-.658*math.Sin(2*D)+
-			-.214*math.Sin(2*Mʹ)+
-			-.11*math.Sin(D))
+	return λ, β, Δ, solar.ApparentLongitude(T), solar.Radius(T) * base.AU
+}
+
+// PhaseAngle computes the phase angle of the Moon at time jde from
+// positions of the Moon and Sun computed with packages moonposition and
+// solar.
+func PhaseAngle(jde float64) unit.Angle {
+	λ, β, Δ, λ0, R := eclipticPositions(jde)
+	return PhaseAngleEcl(λ, β, Δ, λ0, R)
+}
+
+// Elongation computes the geocentric elongation of the Moon from the Sun at
+// time jde, from positions computed as for PhaseAngle.
+func Elongation(jde float64) unit.Angle {
+	λ, β, _, λ0, _ := eclipticPositions(jde)
+	return unit.Angle(math.Acos(cψEcl(λ, β, λ0)))
+}
+
+// Dichotomy finds the time when the phase angle of the Moon is 90°,
+// when the terminator is a straight line and the disk is exactly half
+// illuminated.
+//
+// The time is searched for between jde1 and jde2, which should bracket a
+// single dichotomy.  A range of a few days around a first or last quarter
+// as computed by package moonphase is suitable.  Dichotomy differs from
+// the quarter by up to several hours.
+//
+// The error interp.ErrorZeroOutside is returned if no dichotomy occurs
+// in the range.
+func Dichotomy(jde1, jde2 float64) (float64, error) {
+	f := func(jde float64) float64 {
+		return PhaseAngle(jde).Rad() - math.Pi/2
+	}
+	jde, err := len5Zero(jde1, jde2, f)
+	if err != nil {
+		return 0, err
+	}
+	// refine over a narrower table
+	return len5Zero(jde-.25, jde+.25, f)
+}
+
+func len5Zero(jde1, jde5 float64, f func(float64) float64) (float64, error) {
+	y := make([]float64, 5)
+	for i := range y {
+		y[i] = f(jde1 + float64(i)*(jde5-jde1)/4)
+	}
+	l5, err := interp.NewLen5(jde1, jde5, y)
+	if err != nil {
+		return 0, err
+	}
+	return l5.Zero(true)
+}
+
+// MaxElongation finds the time when the elongation of the Moon from the Sun
+// is greatest between jde1 and jde2.
+//
+// The greatest elongation of a month occurs near full moon.  Over a range
+// not containing a maximum, such as the days of a waxing crescent, the
+// result is the end of the range with the greater elongation.
+//
+// Results are the time and the elongation at that time.
+func MaxElongation(jde1, jde2 float64) (jde float64, ψ unit.Angle, err error) {
+	y := make([]float64, 5)
+	for i := range y {
+		y[i] = Elongation(jde1 + float64(i)*(jde2-jde1)/4).Rad()
+	}
+	l5, err := interp.NewLen5(jde1, jde2, y)
+	if err != nil {
+		return
+	}
+	x, _, xErr := l5.Extremum()
+	if xErr == nil {
+		// refine over a narrower table
+		for i := range y {
+			y[i] = Elongation(x + float64(i-2)*.125).Rad()
+		}
+		if l5, err = interp.NewLen5(x-.25, x+.25, y); err != nil {
+			return
+		}
+		if x, _, xErr = l5.Extremum(); xErr == nil && x >= jde1 && x <= jde2 {
+			if ψx := Elongation(x); ψx > unit.Angle(y[0]) && ψx > unit.Angle(y[4]) {
+				return x, ψx, nil
+			}
+		}
+	}
+	// no maximum inside the range
+	y1, y2 := Elongation(jde1), Elongation(jde2)
+	if y1 > y2 {
+		return jde1, y1, nil
+	}
+	return jde2, y2, nil
 }
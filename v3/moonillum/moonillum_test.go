@@ -7,12 +7,15 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/meeus/v3/moonillum"
+	"github.com/soniakeys/meeus/v3/moonphase"
 	"github.com/soniakeys/meeus/v3/moonposition"
 	"github.com/soniakeys/meeus/v3/solar"
+	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
 )
 
@@ -75,3 +78,52 @@ func ExamplePhaseAngle3() {
 	// i = 68.88
 	// k = 0.6801
 }
+
+func ExampleDichotomy() {
+	// First quarter of January 2024 and the dichotomy near it.
+	q := moonphase.First(2024.05)
+	jde, err := moonillum.Dichotomy(q-2, q+2)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, j := range []float64{q, jde} {
+		y, m, d := julian.JDToCalendar(j)
+		d, f := math.Modf(d)
+		fmt.Printf("%d %s %d %s TD\n", y, time.Month(m), int(d),
+			sexa.FmtTime(unit.TimeFromDay(f)))
+	}
+	fmt.Printf("i = %.4f°\n", moonillum.PhaseAngle(jde).Deg())
+	// Output:
+	// 2024 January 18 3ʰ53ᵐ46ˢ TD
+	// 2024 January 18 3ʰ37ᵐ12ˢ TD
+	// i = 90.0000°
+}
+
+func ExampleMaxElongation() {
+	// Full moon of January 2024 and greatest elongation near it.
+	f := moonphase.Full(2024.05)
+	jde, ψ, err := moonillum.MaxElongation(f-2, f+2)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("full moon      JDE %.4f\n", f)
+	fmt.Printf("max elongation JDE %.4f  ψ = %.3f°\n", jde, ψ.Deg())
+	// Output:
+	// full moon      JDE 2460335.2466
+	// max elongation JDE 2460335.2586  ψ = 175.200°
+}
+
+func TestMaxElongationCrescent(t *testing.T) {
+	// Over a waxing crescent elongation increases throughout,
+	// so the maximum is at the end of the range.
+	n := moonphase.New(2024.05)
+	jde, ψ, err := moonillum.MaxElongation(n+1, n+5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jde != n+5 || ψ != moonillum.Elongation(n+5) {
+		t.Fatal(jde-n, ψ.Deg())
+	}
+}
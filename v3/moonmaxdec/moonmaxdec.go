@@ -8,6 +8,10 @@ import (
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
+	"github.com/soniakeys/meeus/v3/interp"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/nutation"
 	"github.com/soniakeys/unit"
 )
 
@@ -31,6 +35,41 @@ func South(y float64) (jde float64, δ unit.Angle) {
 	return max(y, &sc)
 }
 
+// Refine refines the time and declination of an extreme declination of
+// the Moon, using the ephemeris of package moonposition.
+//
+// Argument jde is a time returned by North or South.  The apparent
+// declination of the Moon is computed at five times centered on jde and
+// the extremum found by interpolation.
+//
+// Returned is the refined jde of the event and the apparent declination of
+// the Moon at that time.
+func Refine(jde float64) (jdeʹ float64, δ unit.Angle, err error) {
+	const h = .25 // days
+	y := make([]float64, 5)
+	for i := range y {
+		y[i] = declination(jde + float64(i-2)*h).Rad()
+	}
+	d5, err := interp.NewLen5(jde-2*h, jde+2*h, y)
+	if err != nil {
+		return
+	}
+	jdeʹ, _, err = d5.Extremum()
+	if err != nil {
+		return
+	}
+	return jdeʹ, declination(jdeʹ), nil
+}
+
+// declination returns the apparent declination of the Moon.
+func declination(jde float64) unit.Angle {
+	λ, β, _ := moonposition.Position(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	sε, cε := (nutation.MeanObliquity(jde) + Δε).Sincos()
+	_, δ := coord.EclToEq(λ+Δψ, β, sε, cε)
+	return δ
+}
+
 const p = math.Pi / 180
 
 func max(y float64, c *mc) (jde float64, δ unit.Angle) {
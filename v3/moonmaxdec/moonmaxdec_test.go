@@ -31,6 +31,24 @@ func ExampleNorth() {
 	// +28°09′22″
 }
 
+func ExampleRefine() {
+	// Example 52.a, p. 370.
+	j, _ := moonmaxdec.North(1988.95)
+	j, δ, err := moonmaxdec.Refine(j)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, m, d := julian.JDToCalendar(j)
+	d, f := math.Modf(d)
+	fmt.Printf("%d %s %d at %0m TD\n", y, time.Month(m), int(d),
+		sexa.FmtTime(unit.TimeFromDay(f)))
+	fmt.Printf("%+0d\n", sexa.FmtAngle(δ))
+	// Output:
+	// 1988 December 22 at 20ʰ01ᵐ TD
+	// +28°09′13″
+}
+
 func ExampleSouth() {
 	// Example 52.b, p. 370.
 	j, δ := moonmaxdec.South(2049.3)
@@ -8,6 +8,10 @@ import (
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/eclipse"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/solar"
+	"github.com/soniakeys/unit"
 )
 
 // Ascending returns the date of passage of the Moon through an ascending node.
@@ -31,22 +35,26 @@ func Descending(year float64) float64 {
 func node(y, h float64) float64 {
 	k := (y - 2000.05) * 13.4223 // (50.1) p. 355
 	k = math.Floor(k-h+.5) + h   // snap to half orbit
+	return nodeK(k)
+}
+
+// nodeK returns the jde of the passage numbered k, where integer k are
+// ascending nodes and k ending in .5 are descending nodes.
+func nodeK(k float64) float64 {
 	const p = math.Pi / 180
 	const ck = 1 / 1342.23
 	T := k * ck
-	D := base.Horner(T, 183.638*p, 331.73735682*p/ck,
-		.0014852*p, .00000209*p, -.00000001*p)
-	M := base.Horner(T, 17.4006*p, 26.8203725*p/ck,
-		.0001186*p, .00000006*p)
-	Mʹ := base.Horner(T, 38.3776*p, 355.52747313*p/ck,
-		.0123499*p, .000014627*p, -.000000069*p)
+	jm := base.Horner(T, 2451565.1619, 27.212220817/ck,
+		.0002762, .000000021, -.000000000088)
+	// arguments at the mean passage jm; equivalent to those of p. 356.
+	aD, aM, aMʹ, _ := moonposition.Arguments(jm)
+	D, M, Mʹ := aD.Rad(), aM.Rad(), aMʹ.Rad()
 	Ω := base.Horner(T, 123.9767*p, -1.44098956*p/ck,
 		.0020608*p, .00000214*p, -.000000016*p)
 	V := base.Horner(T, 299.75*p, 132.85*p, -.009173*p)
 	P := Ω + 272.75*p - 2.3*p*T
 	E := base.Horner(T, 1, -.002516, -.0000074)
-	return base.Horner(T, 2451565.1619, 27.212220817/ck,
-		.0002762, .000000021, -.000000000088) +
+	return jm +
 		-.4721*math.Sin(Mʹ) +
 		-.1649*math.Sin(2*D) +
 		-.0868*math.Sin(2*D-Mʹ) +
@@ -70,3 +78,97 @@ func node(y, h float64) float64 {
 		.0003*math.Sin(V) +
 		.0003*math.Sin(P)
 }
+
+// SeasonLimit is the greatest distance of the Sun from the line of nodes at
+// which a node passage is considered to fall within an eclipse season.
+//
+// A solar eclipse is possible with the Sun up to about 18° from a node,
+// a lunar eclipse up to about 12°.  17° is a common teaching value.  For
+// another limit, compare SunDistance with it directly.
+const SeasonLimit = unit.Angle(17 * math.Pi / 180)
+
+// SunDistance returns the angular distance of the Sun from the nearer
+// node of the Moon's orbit at time jde.
+//
+// The result is in the range [0, π/2].
+func SunDistance(jde float64) unit.Angle {
+	λ := solar.ApparentLongitude(base.J2000Century(jde))
+	d := (λ - moonposition.TrueNode(jde)).Mod1()
+	if d > math.Pi {
+		d -= math.Pi
+	}
+	if d > math.Pi/2 {
+		d = math.Pi - d
+	}
+	return d
+}
+
+// InSeason returns true if a node passage at time jde falls within an
+// eclipse season, that is, if the Sun is within SeasonLimit of the nodes.
+func InSeason(jde float64) bool {
+	return SunDistance(jde) <= SeasonLimit
+}
+
+// Passage describes a passage of the Moon through a node and the eclipses
+// associated with it.
+//
+// An eclipse is associated with the node passage nearest its time of
+// maximum.  Eclipse types are the constants of package eclipse.  When
+// there is no associated eclipse the type is eclipse.None and the time
+// is zero.
+type Passage struct {
+	JDE         float64    // time of the passage
+	Ascending   bool       // true for an ascending node, false for descending
+	SunDistance unit.Angle // distance of the Sun from the line of nodes
+	InSeason    bool       // true if SunDistance is within SeasonLimit
+	Solar       int        // type of associated solar eclipse
+	SolarMax    float64    // jde of greatest solar eclipse
+	Lunar       int        // type of associated lunar eclipse
+	LunarMax    float64    // jde of greatest lunar eclipse
+}
+
+// Passages returns the node passages between decimal years year1 and year2
+// with their associated eclipses.
+func Passages(year1, year2 float64) []Passage {
+	k := math.Floor((year1-2000.05)*13.4223*2) / 2 // (50.1) p. 355
+	j1 := base.JulianYearToJDE(year1)
+	j2 := base.JulianYearToJDE(year2)
+	var ps []Passage
+	for ; ; k += .5 {
+		jde := nodeK(k)
+		if jde < j1 {
+			continue
+		}
+		if jde > j2 {
+			return ps
+		}
+		ps = append(ps, NewPassage(jde, k == math.Floor(k)))
+	}
+}
+
+// NewPassage returns the Passage for a node passage at time jde.
+//
+// Argument jde should be a time returned by Ascending or Descending.
+func NewPassage(jde float64, ascending bool) Passage {
+	d := SunDistance(jde)
+	p := Passage{
+		JDE:         jde,
+		Ascending:   ascending,
+		SunDistance: d,
+		InSeason:    d <= SeasonLimit,
+	}
+	// An eclipse at a new or full moon belongs to this passage if it is
+	// nearer than a quarter of a draconic month, half the interval to
+	// the adjacent passages.
+	const near = 27.2122 / 4
+	y := base.JDEToJulianYear(jde)
+	if t, _, jmax, _, _, _, _ := eclipse.Solar(y); t != eclipse.None &&
+		math.Abs(jmax-jde) < near {
+		p.Solar, p.SolarMax = t, jmax
+	}
+	if t, jmax, _, _, _, _, _, _, _ := eclipse.Lunar(y); t != eclipse.None &&
+		math.Abs(jmax-jde) < near {
+		p.Lunar, p.LunarMax = t, jmax
+	}
+	return p
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/soniakeys/meeus/v3/eclipse"
 	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/meeus/v3/moonnode"
 	"github.com/soniakeys/sexagesimal"
@@ -26,3 +27,46 @@ func ExampleAscending() {
 	// 2446938.76803
 	// 1987 May 23, at 6ʰ25ᵐ58ˢ TD
 }
+
+func ExamplePassages() {
+	// Node passages in the eclipse seasons of 2000 and their eclipses.
+	typ := []string{"", "partial", "annular", "annular-total",
+		"penumbral", "umbral", "total"}
+	date := func(j float64) string {
+		y, m, d := julian.JDToCalendar(j)
+		return fmt.Sprintf("%d %s %d", y, time.Month(m).String()[:3], int(d))
+	}
+	for _, p := range moonnode.Passages(2000, 2001) {
+		if !p.InSeason {
+			continue
+		}
+		node := "descending"
+		if p.Ascending {
+			node = "ascending"
+		}
+		fmt.Printf("%s, %s node, Sun %.1f°\n",
+			date(p.JDE), node, p.SunDistance.Deg())
+		if p.Solar != eclipse.None {
+			fmt.Printf("  %s solar eclipse %s\n",
+				typ[p.Solar], date(p.SolarMax))
+		}
+		if p.Lunar != eclipse.None {
+			fmt.Printf("  %s lunar eclipse %s\n",
+				typ[p.Lunar], date(p.LunarMax))
+		}
+	}
+	// Output:
+	// 2000 Jan 8, descending node, Sun 16.4°
+	// 2000 Jan 21, ascending node, Sun 3.0°
+	//   total lunar eclipse 2000 Jan 21
+	// 2000 Feb 4, descending node, Sun 11.3°
+	//   partial solar eclipse 2000 Feb 5
+	// 2000 Jul 2, ascending node, Sun 13.4°
+	//   partial solar eclipse 2000 Jul 1
+	// 2000 Jul 16, descending node, Sun 0.3°
+	//   total lunar eclipse 2000 Jul 16
+	// 2000 Jul 30, ascending node, Sun 12.6°
+	//   partial solar eclipse 2000 Jul 31
+	// 2000 Dec 26, descending node, Sun 10.3°
+	//   partial solar eclipse 2000 Dec 25
+}
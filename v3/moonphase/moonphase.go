@@ -5,9 +5,14 @@
 package moonphase
 
 import (
+	"errors"
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/iterate"
+	"github.com/soniakeys/meeus/v3/moonillum"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/solar"
 )
 
 const ck = 1 / 1236.85
@@ -92,6 +97,103 @@ func Last(year float64) float64 {
 	return mean(m.T) + m.flc() - m.w() + m.a()
 }
 
+// BrownOffset is the difference between Brown's lunation numbers and the
+// lunation numbers k of this chapter.
+//
+// Brown's lunation 1 began with the New Moon of 1923 January 16.  Lunation 0
+// of this chapter is the New Moon of 2000 January 6.
+const BrownOffset = 953
+
+// Lunation returns the lunation number of the lunation containing jde.
+//
+// The lunation number is k of chapter 49, with 0 the lunation beginning with
+// the New Moon of 2000 January 6.  The lunation containing jde is the one
+// beginning with the latest New Moon at or before jde.
+func Lunation(jde float64) int {
+	// (49.1) p. 349 inverted, ignoring the small higher order terms.
+	k := int(math.Floor((jde - 2451550.09766) / 29.530588861))
+	// the true New Moon differs from the mean by less than a day, so
+	// at most one adjustment is needed.
+	switch {
+	case NewLunation(k) > jde:
+		k--
+	case NewLunation(k+1) <= jde:
+		k++
+	}
+	return k
+}
+
+// BrownLunation returns Brown's lunation number of the lunation containing
+// jde.
+//
+// It is Lunation(jde) + BrownOffset.
+func BrownLunation(jde float64) int {
+	return Lunation(jde) + BrownOffset
+}
+
+// NewLunation returns the jde of the New Moon beginning lunation k.
+//
+// Argument k is a lunation number as returned by Lunation.  For a Brown
+// lunation number b, use NewLunation(b - BrownOffset).
+func NewLunation(k int) float64 {
+	m := newMpK(float64(k))
+	return mean(m.T) + m.nfc(&nc) + m.a()
+}
+
+// fullLunation returns the jde of the Full Moon of lunation k.
+func fullLunation(k int) float64 {
+	m := newMpK(float64(k) + .5)
+	return mean(m.T) + m.nfc(&fc) + m.a()
+}
+
+// phaseAngle returns the phase angle of the Moon at jde.
+func phaseAngle(jde float64) float64 {
+	λ, β, Δ := moonposition.Position(jde)
+	T := base.J2000Century(jde)
+	λ0, _ := solar.True(T)
+	return moonillum.PhaseAngleEcl(λ, β, Δ, λ0, solar.Radius(T)*base.AU).Rad()
+}
+
+// ErrorFraction is returned by Fraction when the Moon does not reach the
+// requested illuminated fraction near the given date.
+var ErrorFraction = errors.New("Fraction not reached")
+
+// Fraction returns the jde nearest a given date when the illuminated
+// fraction of the Moon's disk is k.
+//
+// Argument jde specifies the date, k is the illuminated fraction, 0 to 1.
+// Each fraction is reached twice in a lunation, waxing and waning.  The time
+// nearest jde is returned, considering the lunation containing jde and those
+// before and after.
+//
+// The phase angle is computed from the positions of chapters 25 and 47 and
+// the time is found by bisection.  Fractions very near 0 or 1 are not reached
+// in every lunation.  ErrorFraction is returned if the fraction is not reached
+// in any of the lunations considered.
+func Fraction(jde, k float64) (float64, error) {
+	it := math.Acos(2*k - 1) // (48.1) p. 345 inverted
+	f := func(t float64) float64 { return phaseAngle(t) - it }
+	best := math.Inf(1)
+	try := func(t1, t2 float64) {
+		if (f(t1) < 0) == (f(t2) < 0) {
+			return // no crossing
+		}
+		if t := iterate.BinaryRoot(f, t1, t2); math.Abs(t-jde) < math.Abs(best-jde) {
+			best = t
+		}
+	}
+	L := Lunation(jde)
+	for l := L - 1; l <= L+1; l++ {
+		nm, fm := NewLunation(l), fullLunation(l)
+		try(nm, fm)               // waxing
+		try(fm, NewLunation(l+1)) // waning
+	}
+	if math.IsInf(best, 1) {
+		return 0, ErrorFraction
+	}
+	return best, nil
+}
+
 type mp struct {
 	k, T           float64
 	E, M, Mʹ, F, Ω float64
@@ -101,15 +203,17 @@ type mp struct {
 const p = math.Pi / 180
 
 func newMp(y, q float64) *mp {
-	m := &mp{k: snap(y, q)}
+	return newMpK(snap(y, q))
+}
+
+func newMpK(k float64) *mp {
+	m := &mp{k: k}
 	m.T = m.k * ck // (49.3) p. 350
 	m.E = base.Horner(m.T, 1, -.002516, -.0000074)
-	m.M = base.Horner(m.T, 2.5534*p, 29.1053567*p/ck,
-		-.0000014*p, -.00000011*p)
-	m.Mʹ = base.Horner(m.T, 201.5643*p, 385.81693528*p/ck,
-		.0107582*p, .00001238*p, -.000000058*p)
-	m.F = base.Horner(m.T, 160.7108*p, 390.67050284*p/ck,
-		-.0016118*p, -.00000227*p, .000000011*p)
+	// arguments at the mean phase; equivalent to (49.4) through (49.6)
+	// p. 350.
+	_, M, Mʹ, F := moonposition.Arguments(mean(m.T))
+	m.M, m.Mʹ, m.F = M.Rad(), Mʹ.Rad(), F.Rad()
 	m.Ω = base.Horner(m.T, 124.7746*p, -1.56375588*p/ck,
 		.0020672*p, .00000215*p)
 	m.A[0] = 299.7*p + .107408*p*m.k - .009173*m.T*m.T
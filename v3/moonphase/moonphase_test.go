@@ -5,7 +5,9 @@ package moonphase_test
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/meeus/v3/moonphase"
 )
 
@@ -36,3 +38,31 @@ func ExampleLast() {
 	// Output:
 	// JDE = 2467636.49186
 }
+
+func ExampleLunation() {
+	// New Moon of example 49.a, p. 353.
+	j := moonphase.New(1977.13)
+	k := moonphase.Lunation(j)
+	fmt.Println("Lunation:      ", k)
+	fmt.Println("Brown lunation:", moonphase.BrownLunation(j))
+	fmt.Printf("New Moon JDE:   %.5f\n", moonphase.NewLunation(k))
+	fmt.Println("Day before:    ", moonphase.Lunation(j-1))
+	// Output:
+	// Lunation:       -283
+	// Brown lunation: 670
+	// New Moon JDE:   2443192.65118
+	// Day before:     -284
+}
+
+func ExampleFraction() {
+	// The Moon is 67.86% illuminated at the time of example 48.a, p. 347.
+	j, err := moonphase.Fraction(julian.CalendarGregorianToJD(1992, 4, 10), .6786)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, m, d := julian.JDToCalendar(j)
+	fmt.Printf("%d %s %.3f\n", y, time.Month(m), d)
+	// Output:
+	// 1992 April 12.001
+}
@@ -16,11 +16,39 @@ import (
 // Argument Δ is distance between centers of the Earth and Moon, in km.
 func Parallax(Δ float64) unit.Angle {
 	// p. 337
-	return unit.Angle(math.Asin(6378.14 / Δ))
+	return unit.Angle(math.Asin(base.EarthRadius / Δ))
 }
 
 const p = math.Pi / 180
 
+// Arguments returns the fundamental arguments of the lunar theory,
+// p. 338.
+//
+//	D  Mean elongation of the Moon.
+//	M  Mean anomaly of the Sun.
+//	Mʹ Mean anomaly of the Moon.
+//	F  Argument of latitude of the Moon, mean distance from ascending node.
+//
+// Results are reduced to the range [0, 2π).
+func Arguments(jde float64) (D, M, Mʹ, F unit.Angle) {
+	d, m, mʹ, f := dmf(base.J2000Century(jde))
+	return unit.Angle(d).Mod1(), unit.Angle(m).Mod1(),
+		unit.Angle(mʹ).Mod1(), unit.Angle(f).Mod1()
+}
+
+// MeanLongitude returns the mean longitude Lʹ of the Moon, p. 338.
+//
+// Result is referenced to the mean equinox of date and reduced to the
+// range [0, 2π).
+func MeanLongitude(jde float64) unit.Angle {
+	return unit.Angle(meanLongitude(base.J2000Century(jde))).Mod1()
+}
+
+func meanLongitude(T float64) float64 {
+	return base.Horner(T, 218.3164477*p, 481267.88123421*p,
+		-.0015786*p, p/538841, -p/65194000)
+}
+
 func dmf(T float64) (D, M, Mʹ, F float64) {
 	D = base.Horner(T, 297.8501921*p, 445267.1114034*p,
 		-.0018819*p, p/545868, -p/113065000)
@@ -38,13 +66,12 @@ func dmf(T float64) (D, M, Mʹ, F float64) {
 // Results are referenced to mean equinox of date and do not include
 // the effect of nutation.
 //
-//	λ  Geocentric longitude.
+//	λ  Geocentric longitude, in the range [0, 2π).
 //	β  Geocentric latidude.
 //	Δ  Distance between centers of the Earth and Moon, in km.
 func Position(jde float64) (λ, β unit.Angle, Δ float64) {
 	T := base.J2000Century(jde)
-	Lʹ := base.Horner(T, 218.3164477*p, 481267.88123421*p,
-		-.0015786*p, p/538841, -p/65194000)
+	Lʹ := meanLongitude(T)
 	D, M, Mʹ, F := dmf(T)
 	A1 := 119.75*p + 131.849*p*T
 	A2 := 53.09*p + 479264.29*p*T
@@ -55,40 +82,75 @@ func Position(jde float64) (λ, β unit.Angle, Δ float64) {
 	Σr := 0.
 	Σb := -2235*math.Sin(Lʹ) + 382*math.Sin(A3) + 175*math.Sin(A1-F) +
 		175*math.Sin(A1+F) + 127*math.Sin(Lʹ-Mʹ) - 115*math.Sin(Lʹ+Mʹ)
+	// Sines and cosines of the arguments are formed from multiples of
+	// D, M, Mʹ, F.  E factors are indexed by M+2.
+	mD, mM := base.NewMultiples(D), base.NewMultiples(M)
+	mMʹ, mF := base.NewMultiples(Mʹ), base.NewMultiples(F)
+	eM := [5]float64{E2, E, 1, E, E2}
 	for i := range ta {
 		r := &ta[i]
-		sa, ca := math.Sincos(D*r.D + M*r.M + Mʹ*r.Mʹ + F*r.F)
-		switch r.M {
-		case 0:
-			Σl += r.Σl * sa
-			Σr += r.Σr * ca
-		case 1, -1:
-			Σl += r.Σl * sa * E
-			Σr += r.Σr * ca * E
-		case 2, -2:
-			Σl += r.Σl * sa * E2
-			Σr += r.Σr * ca * E2
-		}
+		z := mD.Exp(r.D) * mM.Exp(r.M) * mMʹ.Exp(r.Mʹ) * mF.Exp(r.F)
+		e := eM[r.M+2]
+		Σl += r.Σl * imag(z) * e
+		Σr += r.Σr * real(z) * e
 	}
 	for i := range tb {
 		r := &tb[i]
-		sb := math.Sin(D*r.D + M*r.M + Mʹ*r.Mʹ + F*r.F)
-		switch r.M {
-		case 0:
-			Σb += r.Σb * sb
-		case 1, -1:
-			Σb += r.Σb * sb * E
-		case 2, -2:
-			Σb += r.Σb * sb * E2
+		z := mD.Exp(r.D) * mM.Exp(r.M) * mMʹ.Exp(r.Mʹ) * mF.Exp(r.F)
+		Σb += r.Σb * imag(z) * eM[r.M+2]
+	}
+	λ = (unit.Angle(Lʹ) + unit.AngleFromDeg(Σl*1e-6)).Mod1()
+	β = unit.AngleFromDeg(Σb * 1e-6)
+	Δ = 385000.56 + Σr*1e-3
+	return
+}
+
+// PositionLow returns a low precision geocentric location of the Moon.
+//
+// It evaluates only the largest terms of tables 47.A and 47.B, those of
+// longitude and distance with coefficients of at least 0.03° and those of
+// latitude of at least 0.04°, and omits the additive terms A1, A2, and A3.
+// Over the years 1900 to 2100 the errors relative to Position are at most
+// about 0.1° in longitude and latitude and 300 km in distance, which is
+// ample for applications such as phase clocks and tide indicators that
+// evaluate positions very often.
+//
+// Results are referenced as for Position.
+func PositionLow(jde float64) (λ, β unit.Angle, Δ float64) {
+	T := base.J2000Century(jde)
+	Lʹ := meanLongitude(T)
+	D, M, Mʹ, F := dmf(T)
+	E := 1 - .002516*T
+	Σl, Σr, Σb := 0., 0., 0.
+	for i := range ta[:nLowA] {
+		r := &ta[i]
+		s, c := math.Sincos(float64(r.D)*D + float64(r.M)*M +
+			float64(r.Mʹ)*Mʹ + float64(r.F)*F)
+		if r.M != 0 {
+			s *= E
+			c *= E
 		}
+		Σl += r.Σl * s
+		Σr += r.Σr * c
+	}
+	for i := range tb[:nLowB] {
+		r := &tb[i]
+		Σb += r.Σb * math.Sin(float64(r.D)*D+float64(r.M)*M+
+			float64(r.Mʹ)*Mʹ+float64(r.F)*F)
 	}
-	λ = unit.Angle(Lʹ).Mod1() + unit.AngleFromDeg(Σl*1e-6)
+	λ = (unit.Angle(Lʹ) + unit.AngleFromDeg(Σl*1e-6)).Mod1()
 	β = unit.AngleFromDeg(Σb * 1e-6)
 	Δ = 385000.56 + Σr*1e-3
 	return
 }
 
-type tas struct{ D, M, Mʹ, F, Σl, Σr float64 }
+// numbers of leading rows of ta and tb used by PositionLow
+const nLowA, nLowB = 13, 6
+
+type tas struct {
+	D, M, Mʹ, F int
+	Σl, Σr      float64
+}
 
 var ta = [...]tas{
 	{0, 0, 1, 0, 6288774, -20905355},
@@ -167,7 +229,10 @@ var ta = [...]tas{
 	{2, 0, -1, -2, 0, 8752},
 }
 
-type tbs struct{ D, M, Mʹ, F, Σb float64 }
+type tbs struct {
+	D, M, Mʹ, F int
+	Σb          float64
+}
 
 var tb = [...]tbs{
 	{0, 0, 0, 1, 5128122},
@@ -261,13 +326,14 @@ func Perigee(jde float64) unit.Angle {
 
 // TrueNode returns longitude of the true ascending node.
 //
-// That is, the node of the instantaneous lunar orbit.
+// That is, the node of the instantaneous lunar orbit.  Result is in the
+// range [0, 2π).
 func TrueNode(jde float64) unit.Angle {
 	D, M, Mʹ, F := dmf(base.J2000Century(jde))
-	return Node(jde) + unit.AngleFromDeg(
+	return (Node(jde) + unit.AngleFromDeg(
 		-1.4979*math.Sin(2*(D-F))+
 			-.15*math.Sin(M)+
 			-.1226*math.Sin(2*D)+
 			.1176*math.Sin(2*F)+
-			-.0801*math.Sin(2*(Mʹ-F)))
+			-.0801*math.Sin(2*(Mʹ-F)))).Mod1()
 }
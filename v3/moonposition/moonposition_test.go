@@ -87,3 +87,76 @@ func TestNode180(t *testing.T) {
 		}
 	}
 }
+
+func ExampleArguments() {
+	// Example 47.a, p. 342.
+	jde := julian.CalendarGregorianToJD(1992, 4, 12)
+	D, M, Mʹ, F := moonposition.Arguments(jde)
+	fmt.Printf("Lʹ = %.6f\n", moonposition.MeanLongitude(jde).Deg())
+	fmt.Printf("D = %.6f\n", D.Deg())
+	fmt.Printf("M = %.6f\n", M.Deg())
+	fmt.Printf("Mʹ = %.6f\n", Mʹ.Deg())
+	fmt.Printf("F = %.6f\n", F.Deg())
+	// Output:
+	// Lʹ = 134.290182
+	// D = 113.842304
+	// M = 97.643514
+	// Mʹ = 5.150833
+	// F = 219.889721
+}
+
+func BenchmarkPosition(b *testing.B) {
+	jde := julian.CalendarGregorianToJD(1992, 4, 12)
+	for i := 0; i < b.N; i++ {
+		moonposition.Position(jde)
+	}
+}
+
+func ExamplePositionLow() {
+	// Example 47.a, p. 342, with the low precision series.
+	λ, β, Δ := moonposition.PositionLow(julian.CalendarGregorianToJD(1992, 4, 12))
+	fmt.Printf("λ = %.2f\n", λ.Deg())
+	fmt.Printf("β = %.2f\n", β.Deg())
+	fmt.Printf("Δ = %.0f km\n", Δ)
+	// Output:
+	// λ = 133.15
+	// β = -3.25
+	// Δ = 368392 km
+}
+
+func BenchmarkPositionLow(b *testing.B) {
+	jde := julian.CalendarGregorianToJD(1992, 4, 12)
+	for i := 0; i < b.N; i++ {
+		moonposition.PositionLow(jde)
+	}
+}
+
+func TestPositionLow(t *testing.T) {
+	for jde := 2415020.5; jde < 2488070; jde += 3.7 {
+		λ, β, Δ := moonposition.Position(jde)
+		λl, βl, Δl := moonposition.PositionLow(jde)
+		if d := math.Abs(math.Remainder((λl - λ).Rad(), 2*math.Pi)); d > .11*math.Pi/180 {
+			t.Fatal(jde, "λ", d)
+		}
+		if d := math.Abs((βl - β).Rad()); d > .11*math.Pi/180 {
+			t.Fatal(jde, "β", d)
+		}
+		if d := math.Abs(Δl - Δ); d > 310 {
+			t.Fatal(jde, "Δ", d)
+		}
+	}
+}
+
+func TestLongitudeRange(t *testing.T) {
+	for jde := 2415020.; jde < 2488070; jde += 13.7 {
+		λ, _, _ := moonposition.Position(jde)
+		for i, a := range []float64{λ.Rad(), moonposition.Node(jde).Rad(),
+			moonposition.TrueNode(jde).Rad(),
+			moonposition.Perigee(jde).Rad(),
+			moonposition.MeanLongitude(jde).Rad()} {
+			if a < 0 || a >= 2*math.Pi {
+				t.Fatal(jde, i, a)
+			}
+		}
+	}
+}
@@ -83,3 +83,67 @@ func (e *Elements) AnomalyDistance(jde float64) (ν unit.Angle, r float64, err e
 	}
 	return
 }
+
+// TimeP returns the time of perihelion of a body in a near-parabolic orbit
+// given its true anomaly ν observed at time jde.
+//
+// Arguments q and e are perihelion distance in AU and eccentricity.
+// Negative ν, or ν greater than π, gives a time of perihelion after jde.
+//
+// This is the inverse of Elements.AnomalyDistance, evaluating the same
+// series for a known s = tan ν/2.  An error is returned if the series does
+// not converge.
+func TimeP(jde, q, e float64, ν unit.Angle) (float64, error) {
+	q1 := base.K * math.Sqrt((1+e)/q) / (2 * q)
+	g := (1 - e) / (1 + e)
+	s := math.Tan(math.Remainder(ν.Rad(), 2*math.Pi) / 2)
+	// At convergence of AnomalyDistance, s + s³/3 = q3, where q3 is q2
+	// plus the series in s.  Solve for q2.
+	d1, d := 10000., 1e-9
+	z := 1.
+	y := s * s
+	g1 := -y * s
+	q3 := s + s*y/3 - 2*g*s*y/3
+	if e != 1 {
+		for {
+			z += 1
+			g1 = -g1 * g * y
+			z1 := (z - (z+1)*g) / (2*z + 1)
+			f := z1 * g1
+			q3 -= f
+			if z > 50 || math.Abs(f) > d1 {
+				return 0, errors.New("No convergence")
+			}
+			if math.Abs(f) <= d {
+				break
+			}
+		}
+	}
+	return jde - q3/q1, nil
+}
+
+// TimePDistance returns the time of perihelion of a body in a near-parabolic
+// orbit given its distance r from the Sun observed at time jde.
+//
+// Arguments q and e are perihelion distance in AU and eccentricity, r is
+// distance in AU.  As a distance occurs both before and after perihelion,
+// argument outbound selects the solution where the body is receding from
+// the Sun.
+//
+// An error is returned if r is not a distance on the orbit or if the series
+// does not converge.
+func TimePDistance(jde, q, e, r float64, outbound bool) (float64, error) {
+	// inverse of r = q(1 + e) / (1 + e cos ν)
+	cν := (q*(1+e)/r - 1) / e
+	if r < q || cν < -1 {
+		return 0, errors.New("Distance not on orbit")
+	}
+	if cν > 1 { // r = q, within rounding
+		cν = 1
+	}
+	ν := unit.Angle(math.Acos(cν))
+	if !outbound {
+		ν = -ν
+	}
+	return TimeP(jde, q, e, ν)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/nearparabolic"
+	"github.com/soniakeys/unit"
 )
 
 type tc struct {
@@ -94,3 +95,27 @@ func TestAnomalyDistance2(t *testing.T) {
 		}
 	}
 }
+
+func TestTimeP(t *testing.T) {
+	for _, d := range tdat {
+		T, err := nearparabolic.TimeP(base.J2000, d.q, d.e,
+			unit.AngleFromDeg(d.ν))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		// tolerance allows for rounding of ν in the test data
+		if math.Abs(base.J2000-T-d.t) > 1e-4+1e-6*math.Abs(d.t) {
+			t.Errorf("got t = %.6f expected %.6f", base.J2000-T, d.t)
+		}
+		T, err = nearparabolic.TimePDistance(base.J2000, d.q, d.e, d.r,
+			d.t >= 0)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if math.Abs(base.J2000-T-d.t) > 1e-3 {
+			t.Errorf("got t = %.6f expected %.6f", base.J2000-T, d.t)
+		}
+	}
+}
@@ -5,9 +5,11 @@
 package node
 
 import (
+	"errors"
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/iterate"
 	"github.com/soniakeys/unit"
 )
 
@@ -67,3 +69,82 @@ func pa(ν unit.Angle, q, timeP float64) (jde, r float64) {
 	r = q * (1 + s*s)
 	return
 }
+
+// ErrorLatitude is returned when a latitude is not reached by an orbit,
+// that is, when it exceeds the inclination of the orbit.
+var ErrorLatitude = errors.New("latitude not reached by orbit")
+
+// ErrorNoCrossing is returned by LatitudeCrossing when latitude does not
+// pass through the target value between the given times.
+var ErrorNoCrossing = errors.New("no crossing of latitude in time range")
+
+// argLat returns the two arguments of latitude u at which an orbit of
+// inclination inc reaches heliocentric ecliptic latitude β.
+//
+// The first is where latitude is increasing, the second where it is
+// decreasing.  For β = 0 these are the ascending and descending nodes.
+func argLat(inc, β unit.Angle) (u1, u2 unit.Angle, err error) {
+	s := β.Sin() / inc.Sin()
+	if math.Abs(s) > 1 {
+		return 0, 0, ErrorLatitude
+	}
+	u1 = unit.Angle(math.Asin(s))
+	return u1, math.Pi - u1, nil
+}
+
+// EllipticLatitude computes times and distances of passage through a
+// heliocentric ecliptic latitude of a body in an elliptical orbit.
+//
+// Argument axis is semimajor axis in AU, ecc is eccentricity, inc is
+// inclination, argP is argument of perihelion, β is the target latitude,
+// timeP is time of perihelion as a jd.
+//
+// Results jde1, r1 are the jde and distance from the sun in AU of the passage
+// with latitude increasing, jde2, r2 those of the passage with latitude
+// decreasing.  For β = 0 these are the ascending and descending nodes.
+// ErrorLatitude is returned if |β| exceeds the inclination.
+func EllipticLatitude(axis, ecc float64, inc, argP, β unit.Angle, timeP float64) (jde1, r1, jde2, r2 float64, err error) {
+	u1, u2, err := argLat(inc, β)
+	if err != nil {
+		return
+	}
+	jde1, r1 = el(u1-argP, axis, ecc, timeP)
+	jde2, r2 = el(u2-argP, axis, ecc, timeP)
+	return
+}
+
+// ParabolicLatitude computes times and distances of passage through a
+// heliocentric ecliptic latitude of a body in a parabolic orbit.
+//
+// Argument q is perihelion distance in AU, inc is inclination, argP is
+// argument of perihelion, β is the target latitude, timeP is time of
+// perihelion as a jd.
+//
+// Results are as for EllipticLatitude.
+func ParabolicLatitude(q float64, inc, argP, β unit.Angle, timeP float64) (jde1, r1, jde2, r2 float64, err error) {
+	u1, u2, err := argLat(inc, β)
+	if err != nil {
+		return
+	}
+	jde1, r1 = pa(u1-argP, q, timeP)
+	jde2, r2 = pa(u2-argP, q, timeP)
+	return
+}
+
+// LatitudeCrossing finds the time when an ecliptic latitude given by a
+// function reaches a target value.
+//
+// Argument lat returns latitude for a jde.  It may be heliocentric or
+// geocentric, for example computed from a planetary or lunar theory.
+// Argument β is the target latitude, jde1 and jde2 bracket the crossing.
+//
+// The crossing is found by bisection.  If lat - β changes sign more than once
+// between jde1 and jde2, any one of the crossings may be found.
+// ErrorNoCrossing is returned if it has the same sign at jde1 and jde2.
+func LatitudeCrossing(lat func(jde float64) unit.Angle, β unit.Angle, jde1, jde2 float64) (float64, error) {
+	f := func(jde float64) float64 { return (lat(jde) - β).Rad() }
+	if (f(jde1) < 0) == (f(jde2) < 0) {
+		return 0, ErrorNoCrossing
+	}
+	return iterate.BinaryRoot(f, jde1, jde2), nil
+}
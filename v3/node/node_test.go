@@ -5,9 +5,13 @@ package node_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
 	"time"
 
+	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/kepler"
 	"github.com/soniakeys/meeus/v3/node"
 	"github.com/soniakeys/meeus/v3/perihelion"
 	"github.com/soniakeys/meeus/v3/planetelements"
@@ -79,3 +83,75 @@ func ExampleEllipticAscending_venus() {
 	// Output:
 	// 1978 November 27.409
 }
+
+func ExampleEllipticLatitude() {
+	// Halley's comet, elements of example 39.a, p. 276, passing 10° north
+	// of the ecliptic.
+	t1, r1, t2, r2, err := node.EllipticLatitude(17.9400782, .96727426,
+		unit.AngleFromDeg(162.23932), unit.AngleFromDeg(111.84644),
+		unit.AngleFromDeg(10),
+		julian.CalendarGregorianToJD(1986, 2, 9.45891))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, p := range []struct{ t, r float64 }{{t1, r1}, {t2, r2}} {
+		y, m, d := julian.JDToCalendar(p.t)
+		fmt.Printf("%d %s %.2f  %.4f AU\n", y, time.Month(m), d, p.r)
+	}
+	// Output:
+	// 1986 January 4.70  0.9505 AU
+	// 1986 February 20.99  0.6392 AU
+}
+
+// halley returns heliocentric ecliptic latitude of Halley's comet from the
+// elements of example 39.a.
+func halley(jde float64) unit.Angle {
+	const a, e = 17.9400782, .96727426
+	n := base.K / a / math.Sqrt(a)
+	M := unit.Angle(n * (jde - julian.CalendarGregorianToJD(1986, 2, 9.45891)))
+	E, err := kepler.Kepler2b(e, M, 15)
+	if err != nil {
+		panic(err)
+	}
+	u := kepler.True(E, e) + unit.AngleFromDeg(111.84644)
+	return unit.Angle(math.Asin(unit.AngleFromDeg(162.23932).Sin() * u.Sin()))
+}
+
+func TestEllipticLatitude(t *testing.T) {
+	tp := julian.CalendarGregorianToJD(1986, 2, 9.45891)
+	ω := unit.AngleFromDeg(111.84644)
+	i := unit.AngleFromDeg(162.23932)
+	// nodes are the special case β = 0
+	t1, r1, t2, r2, err := node.EllipticLatitude(17.9400782, .96727426,
+		i, ω, 0, tp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ta, ra := node.EllipticAscending(17.9400782, .96727426, ω, tp)
+	td, rd := node.EllipticDescending(17.9400782, .96727426, ω, tp)
+	if t1 != ta || r1 != ra || t2 != td || r2 != rd {
+		t.Fatal("nodes:", t1, t2, "want", ta, td)
+	}
+	// latitude beyond inclination
+	if _, _, _, _, err = node.EllipticLatitude(17.9400782, .96727426,
+		i, ω, unit.AngleFromDeg(20), tp); err != node.ErrorLatitude {
+		t.Fatal("want ErrorLatitude, got", err)
+	}
+	// agree with root found from the latitude function
+	β := unit.AngleFromDeg(10)
+	t1, _, t2, _, _ = node.EllipticLatitude(17.9400782, .96727426,
+		i, ω, β, tp)
+	for _, want := range []float64{t1, t2} {
+		got, err := node.LatitudeCrossing(halley, β, want-5, want+5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got-want) > 1e-6 {
+			t.Fatal("LatitudeCrossing:", got, "want", want)
+		}
+	}
+	if _, err = node.LatitudeCrossing(halley, β, t1+5, t1+6); err != node.ErrorNoCrossing {
+		t.Fatal("want ErrorNoCrossing, got", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/interp"
 	"github.com/soniakeys/unit"
 )
 
@@ -29,14 +30,17 @@ func Nutation(jde float64) (Δψ, Δε unit.Angle) {
 		93.27191, 483202.017538, -0.0036825, 1./327270) * math.Pi / 180
 	Ω := base.Horner(T,
 		125.04452, -1934.136261, 0.0020708, 1./450000) * math.Pi / 180
+	// sines and cosines of arguments are formed from multiples
+	mD, mM, mN := base.NewMultiples(D), base.NewMultiples(M), base.NewMultiples(N)
+	mF, mΩ := base.NewMultiples(F), base.NewMultiples(Ω)
 	// sum in reverse order to accumulate smaller terms first
 	var Δψs, Δεs float64
 	for i := len(table22A) - 1; i >= 0; i-- {
-		row := table22A[i]
-		arg := row.d*D + row.m*M + row.n*N + row.f*F + row.ω*Ω
-		s, c := math.Sincos(arg)
-		Δψs += s * (row.s0 + row.s1*T)
-		Δεs += c * (row.c0 + row.c1*T)
+		row := &table22A[i]
+		z := mD.Exp(row.d) * mM.Exp(row.m) * mN.Exp(row.n) *
+			mF.Exp(row.f) * mΩ.Exp(row.ω)
+		Δψs += imag(z) * (row.s0 + row.s1*T)
+		Δεs += real(z) * (row.c0 + row.c1*T)
 	}
 	Δψ = unit.AngleFromSec(Δψs * .0001)
 	Δε = unit.AngleFromSec(Δεs * .0001)
@@ -61,6 +65,61 @@ func ApproxNutation(jde float64) (Δψ, Δε unit.Angle) {
 	return
 }
 
+// Interpolator approximates Nutation by interpolating a table of values
+// precomputed over a range of dates.
+//
+// It is of use to programs evaluating nutation very many times over a
+// limited range of dates.
+type Interpolator struct {
+	jde1, jde2 float64
+	step       float64
+	ψ, ε       []float64 // radians
+}
+
+// NewInterpolator precomputes nutation for the range jde1 to jde2 at
+// intervals of step days.
+//
+// The interval step sets the accuracy.  The shortest periodic terms of
+// table 22.A have periods near 5.6 days; a step of 1 day gives results
+// within about .0002″ of Nutation.
+func NewInterpolator(jde1, jde2, step float64) *Interpolator {
+	n := int(math.Ceil((jde2-jde1)/step)) + 5
+	p := &Interpolator{
+		jde1: jde1,
+		jde2: jde2,
+		step: step,
+		ψ:    make([]float64, n),
+		ε:    make([]float64, n),
+	}
+	for i := range p.ψ {
+		Δψ, Δε := Nutation(jde1 + float64(i-2)*step)
+		p.ψ[i] = Δψ.Rad()
+		p.ε[i] = Δε.Rad()
+	}
+	return p
+}
+
+// Nutation returns nutation in longitude (Δψ) and nutation in obliquity (Δε)
+// for a given JDE.
+//
+// Results are interpolated from the five table values nearest jde.  For
+// jde outside the range given to NewInterpolator, the function Nutation is
+// evaluated directly.
+func (p *Interpolator) Nutation(jde float64) (Δψ, Δε unit.Angle) {
+	if jde < p.jde1 || jde > p.jde2 {
+		return Nutation(jde)
+	}
+	i := int((jde-p.jde1)/p.step + .5)
+	x1 := p.jde1 + float64(i-2)*p.step
+	x5 := x1 + 4*p.step
+	dψ, err := interp.NewLen5(x1, x5, p.ψ[i:i+5])
+	if err != nil {
+		return Nutation(jde)
+	}
+	dε, _ := interp.NewLen5(x1, x5, p.ε[i:i+5])
+	return unit.Angle(dψ.InterpolateX(jde)), unit.Angle(dε.InterpolateX(jde))
+}
+
 // MeanObliquity returns mean obliquity (ε₀) following the IAU 1980
 // polynomial.
 //
@@ -108,7 +167,7 @@ func NutationInRA(jde float64) unit.HourAngle {
 }
 
 var table22A = []struct {
-	d, m, n, f, ω  float64
+	d, m, n, f, ω  int
 	s0, s1, c0, c1 float64
 }{
 	{0, 0, 0, 0, 1, -171996, -174.2, 92025, 8.9},
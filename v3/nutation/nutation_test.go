@@ -59,3 +59,26 @@ func TestIAUvsLaskar(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpolator(t *testing.T) {
+	jde1 := julian.CalendarGregorianToJD(2020, 1, 1)
+	jde2 := julian.CalendarGregorianToJD(2021, 1, 1)
+	p := nutation.NewInterpolator(jde1, jde2, 1)
+	var mψ, mε float64
+	for jde := jde1; jde <= jde2; jde += .37 {
+		Δψ, Δε := nutation.Nutation(jde)
+		iψ, iε := p.Nutation(jde)
+		mψ = math.Max(mψ, math.Abs((Δψ - iψ).Sec()))
+		mε = math.Max(mε, math.Abs((Δε - iε).Sec()))
+	}
+	if mψ > 2e-4 || mε > 2e-4 {
+		t.Fatal(mψ, mε)
+	}
+}
+
+func BenchmarkNutation(b *testing.B) {
+	jde := julian.CalendarGregorianToJD(1987, 4, 10)
+	for i := 0; i < b.N; i++ {
+		nutation.Nutation(jde)
+	}
+}
@@ -5,6 +5,7 @@
 package parabolic
 
 import (
+	"errors"
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
@@ -29,3 +30,39 @@ func (e *Elements) AnomalyDistance(jde float64) (ν unit.Angle, r float64) {
 	r = e.PDis * (1 + s*s)
 	return
 }
+
+// TimeP returns the time of perihelion of a body in a parabolic orbit
+// given its true anomaly ν observed at time jde.
+//
+// Argument q is perihelion distance in AU.  Negative ν, or ν greater than
+// π, gives a time of perihelion after jde.
+//
+// This is the inverse of Elements.AnomalyDistance.
+func TimeP(jde, q float64, ν unit.Angle) float64 {
+	s := math.Tan(ν.Rad() / 2)
+	// s³ + 3s - W = 0, as solved in AnomalyDistance
+	W := s * (s*s + 3)
+	return jde - W*q*math.Sqrt(q)*math.Sqrt2/(3*base.K)
+}
+
+// ErrorDistance is returned by TimePDistance for a distance less than
+// the perihelion distance.
+var ErrorDistance = errors.New("distance less than perihelion distance")
+
+// TimePDistance returns the time of perihelion of a body in a parabolic
+// orbit given its distance r from the Sun observed at time jde.
+//
+// Argument q is perihelion distance, r is distance, both in AU.  As a
+// distance occurs both before and after perihelion, argument outbound
+// selects the solution where the body is receding from the Sun.
+func TimePDistance(jde, q, r float64, outbound bool) (float64, error) {
+	if r < q {
+		return 0, ErrorDistance
+	}
+	// r = q(1 + s²), as in AnomalyDistance
+	s := math.Sqrt(r/q - 1)
+	if !outbound {
+		s = -s
+	}
+	return TimeP(jde, q, unit.Angle(2*math.Atan(s))), nil
+}
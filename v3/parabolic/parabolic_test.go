@@ -8,6 +8,7 @@ import (
 
 	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/meeus/v3/parabolic"
+	"github.com/soniakeys/unit"
 )
 
 func ExampleElements_AnomalyDistance() {
@@ -24,3 +25,21 @@ func ExampleElements_AnomalyDistance() {
 	// 66.78862 deg
 	// 2.133911 AU
 }
+
+func ExampleTimeP() {
+	// Example 34.a, p. 243, inverted.
+	j := julian.CalendarGregorianToJD(1998, 8, 5)
+	T := parabolic.TimeP(j, 1.487469, unit.AngleFromDeg(66.78862))
+	y, m, d := julian.JDToCalendar(T)
+	fmt.Printf("%d %d %.4f\n", y, m, d)
+	T, err := parabolic.TimePDistance(j, 1.487469, 2.133911, true)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, m, d = julian.JDToCalendar(T)
+	fmt.Printf("%d %d %.4f\n", y, m, d)
+	// Output:
+	// 1998 4 14.4358
+	// 1998 4 14.4358
+}
@@ -7,6 +7,9 @@ package parallactic
 import (
 	"math"
 
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/nutation"
+	"github.com/soniakeys/meeus/v3/sidereal"
 	"github.com/soniakeys/unit"
 )
 
@@ -52,6 +55,149 @@ func EclipticAtHorizon(ε, φ unit.Angle, θ unit.Time) (λ1, λ2, I unit.Angle)
 	return λ, λ + math.Pi, unit.Angle(math.Acos(cε*sφ - sε*cφ*sθ))
 }
 
+// EclipticRising returns the ecliptic longitude of the point of the
+// ecliptic rising on the eastern horizon, the ascendant.
+//
+// Arguments are as for EclipticAtHorizon.  The result is whichever of λ1
+// and λ2 of EclipticAtHorizon is on the eastern horizon.
+func EclipticRising(ε, φ unit.Angle, θ unit.Time) unit.Angle {
+	sε, cε := ε.Sincos()
+	sθ, cθ := θ.Angle().Sincos()
+	return unit.Angle(math.Atan2(cθ, -sθ*cε-φ.Tan()*sε)).Mod1()
+}
+
+// EclipticHorizon holds the intersection of the ecliptic and the horizon at
+// one time of a series computed by EclipticAtHorizonSeries.
+type EclipticHorizon struct {
+	JD      float64    // Julian day, UT
+	Rising  unit.Angle // ecliptic longitude on the eastern horizon
+	Setting unit.Angle // ecliptic longitude on the western horizon
+	I       unit.Angle // angle between the ecliptic and the horizon
+}
+
+// EclipticAtHorizonSeries computes the intersection of the ecliptic and the
+// horizon at regular times, for example over a night.
+//
+//	jd1, jd2 are the first and last Julian days (UT) of the series.
+//	step is the interval between times, in days.
+//	p is geographic coordinates of the observer, longitude positive west.
+//
+// Local sidereal time is apparent sidereal time and ε is the true obliquity
+// of the ecliptic at each time.  The series includes jd1 and continues
+// while the time does not exceed jd2.
+func EclipticAtHorizonSeries(jd1, jd2, step float64, p globe.Coord) []EclipticHorizon {
+	var s []EclipticHorizon
+	for i := 0; ; i++ {
+		jd := jd1 + float64(i)*step
+		if jd > jd2 {
+			break
+		}
+		_, Δε := nutation.Nutation(jd)
+		ε := nutation.MeanObliquity(jd) + Δε
+		θ := sidereal.Apparent(jd) - unit.TimeFromRad(p.Lon.Rad())
+		_, _, I := EclipticAtHorizon(ε, p.Lat, θ)
+		r := EclipticRising(ε, p.Lat, θ)
+		s = append(s, EclipticHorizon{jd, r, (r + math.Pi).Mod1(), I})
+	}
+	return s
+}
+
+// Mark identifies points of interest in a ParallacticAngleSeries.
+type Mark int
+
+// Marks of ParallacticPoint.
+const (
+	NoMark       Mark = iota
+	ZeroCrossing      // parallactic angle passed through zero since the previous point
+	Maximum           // local maximum of the parallactic angle
+	Minimum           // local minimum of the parallactic angle
+)
+
+var markName = [...]string{"", "zero", "max", "min"}
+
+func (m Mark) String() string {
+	return markName[m]
+}
+
+// ParallacticPoint holds the parallactic angle of an object at one time of
+// a series computed by ParallacticAngleSeries.
+type ParallacticPoint struct {
+	JD   float64        // Julian day, UT
+	H    unit.HourAngle // local hour angle of the object, in the range [-π, π]
+	Alt  unit.Angle     // geometric altitude of the object
+	Q    unit.Angle     // parallactic angle, in the range (-π, π]
+	Rate unit.Angle     // rate of change of Q, per day
+	Mark Mark
+}
+
+// siderealRate is the rate of Earth rotation, in radians per UT day.
+const siderealRate = 2 * math.Pi * 1.00273790935
+
+// ParallacticAngleSeries computes the parallactic angle of an object at
+// regular times, for example over a night.
+//
+//	α, δ are equatorial coordinates of the object.
+//	jd1, jd2 are the first and last Julian days (UT) of the series.
+//	step is the interval between times, in days.
+//	p is geographic coordinates of the observer, longitude positive west.
+//
+// The object is taken to be fixed, as for a star.  Local hour angle uses
+// apparent sidereal time, so α, δ should be apparent coordinates.  The series
+// includes jd1 and continues while the time does not exceed jd2.
+//
+// Rate is the rate of field rotation seen by an alt-azimuth mounted
+// telescope.  It grows without bound for an object passing near the zenith.
+//
+// Points are marked where the parallactic angle crosses zero, which happens
+// at upper culmination of an object culminating on the equator side of the
+// zenith, and at interior local extrema, which occur as an object crosses the
+// prime vertical.  A jump between π and -π, as at culmination of an object
+// between the zenith and the pole, is not marked.
+func ParallacticAngleSeries(α unit.RA, δ unit.Angle, jd1, jd2, step float64, p globe.Coord) []ParallacticPoint {
+	var s []ParallacticPoint
+	sφ, cφ := p.Lat.Sincos()
+	sδ, cδ := δ.Sincos()
+	for i := 0; ; i++ {
+		jd := jd1 + float64(i)*step
+		if jd > jd2 {
+			break
+		}
+		H := sidereal.LocalHourAngle(sidereal.Apparent(jd), p.Lon, α)
+		cH := H.Cos()
+		sh := sφ*sδ + cφ*cδ*cH
+		ch := math.Sqrt(1 - sh*sh)
+		// cosine of azimuth from the North, times cos h
+		cAch := (sδ - sφ*sh) / cφ
+		s = append(s, ParallacticPoint{
+			JD:   jd,
+			H:    H,
+			Alt:  unit.Angle(math.Asin(sh)),
+			Q:    ParallacticAngle(p.Lat, δ, H),
+			Rate: unit.Angle(-siderealRate * cφ * cAch / (ch * ch)),
+		})
+	}
+	for i := 1; i < len(s); i++ {
+		q0, q1 := s[i-1].Q, s[i].Q
+		if math.Abs((q1-q0).Rad()) < math.Pi &&
+			(q0 < 0) != (q1 < 0) {
+			s[i].Mark = ZeroCrossing
+		}
+		if i == len(s)-1 {
+			break
+		}
+		q2 := s[i+1].Q
+		switch {
+		case math.Abs((q2 - q0).Rad()) >= math.Pi:
+			// jump between π and -π
+		case q1 > q0 && q1 >= q2:
+			s[i].Mark = Maximum
+		case q1 < q0 && q1 <= q2:
+			s[i].Mark = Minimum
+		}
+	}
+	return s
+}
+
 // EclipticAtEquator computes the angle between the ecliptic and the parallels
 // of ecliptic latitude at a given ecliptic longitude.
 //
@@ -8,6 +8,8 @@ import (
 	"math"
 	"testing"
 
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/meeus/v3/parallactic"
 	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
@@ -27,6 +29,35 @@ func ExampleEclipticAtHorizon() {
 	// 61°53′14″
 }
 
+func ExampleEclipticRising() {
+	// Same inputs as example for EclipticAtHorizon.
+	ε := unit.AngleFromDeg(23.44)
+	φ := unit.AngleFromDeg(51)
+	θ := unit.TimeFromHour(5)
+	fmt.Println(sexa.FmtAngle(parallactic.EclipticRising(ε, φ, θ)))
+	// Output:
+	// 169°21′30″
+}
+
+func ExampleEclipticAtHorizonSeries() {
+	// A spring evening at Greenwich, when the ecliptic stands steep in the
+	// west, favorable for the zodiacal light.
+	p := globe.Coord{Lat: unit.AngleFromDeg(51.48)}
+	jd1 := julian.CalendarGregorianToJD(1990, 3, 21.75)
+	jd2 := julian.CalendarGregorianToJD(1990, 3, 21.95)
+	for _, e := range parallactic.EclipticAtHorizonSeries(jd1, jd2, 1./24, p) {
+		_, _, d := julian.JDToCalendar(e.JD)
+		fmt.Printf("%.0fʰ UT  rising %5.1f°  setting %4.1f°  I %.1f°\n",
+			math.Mod(d, 1)*24, e.Rising.Deg(), e.Setting.Deg(), e.I.Deg())
+	}
+	// Output:
+	// 18ʰ UT  rising 179.3°  setting 359.3°  I 62.0°
+	// 19ʰ UT  rising 189.9°  setting  9.9°  I 61.5°
+	// 20ʰ UT  rising 200.5°  setting 20.5°  I 59.9°
+	// 21ʰ UT  rising 211.0°  setting 31.0°  I 57.3°
+	// 22ʰ UT  rising 221.5°  setting 41.5°  I 53.8°
+}
+
 func TestDiurnalPathAtHorizon(t *testing.T) {
 	φ := unit.AngleFromDeg(40)
 	ε := unit.AngleFromDeg(23.44)
@@ -41,3 +72,64 @@ func TestDiurnalPathAtHorizon(t *testing.T) {
 		t.Fatal("solstice:", sexa.FmtAngle(J))
 	}
 }
+
+func ExampleParallacticAngleSeries() {
+	// Altair observed from Mauna Kea through the night of 2020 July 15.
+	// Altair culminates south of the zenith and crosses the prime vertical.
+	p := globe.Coord{
+		Lat: unit.AngleFromDeg(19.826),
+		Lon: unit.AngleFromDeg(155.472),
+	}
+	α := unit.NewRA(19, 51, 50)
+	δ := unit.NewAngle(' ', 8, 55, 27)
+	jd1 := julian.CalendarGregorianToJD(2020, 7, 16.25)
+	jd2 := julian.CalendarGregorianToJD(2020, 7, 16.65)
+	for _, q := range parallactic.ParallacticAngleSeries(α, δ, jd1, jd2, .025, p) {
+		if q.Alt < 0 {
+			continue
+		}
+		_, _, d := julian.JDToCalendar(q.JD)
+		fmt.Printf("%5.2fʰ UT  H %+5.2fʰ  h %4.1f°  q %+5.1f°  rate %+5.1f°/h",
+			math.Mod(d, 1)*24, q.H.Hour(), q.Alt.Deg(), q.Q.Deg(),
+			q.Rate.Deg()/24)
+		if q.Mark != parallactic.NoMark {
+			fmt.Print("  ", q.Mark)
+		}
+		fmt.Println()
+	}
+	// Output:
+	// 6.00ʰ UT  H -4.59ʰ  h 22.8°  q -72.2°  rate  -0.4°/h
+	//  6.60ʰ UT  H -3.99ʰ  h 31.3°  q -72.2°  rate  +0.4°/h  min
+	//  7.20ʰ UT  H -3.39ʰ  h 39.7°  q -71.6°  rate  +1.6°/h
+	//  7.80ʰ UT  H -2.79ʰ  h 48.2°  q -70.2°  rate  +3.3°/h
+	//  8.40ʰ UT  H -2.19ʰ  h 56.5°  q -67.4°  rate  +6.3°/h
+	//  9.00ʰ UT  H -1.58ʰ  h 64.6°  q -62.0°  rate +12.3°/h
+	//  9.60ʰ UT  H -0.98ʰ  h 72.1°  q -51.0°  rate +26.5°/h
+	// 10.20ʰ UT  H -0.38ʰ  h 77.8°  q -26.3°  rate +59.2°/h
+	// 10.80ʰ UT  H +0.22ʰ  h 78.6°  q +16.0°  rate +68.8°/h  zero
+	// 11.40ʰ UT  H +0.82ʰ  h 73.8°  q +46.2°  rate +33.2°/h
+	// 12.00ʰ UT  H +1.42ʰ  h 66.7°  q +59.8°  rate +15.0°/h
+	// 12.60ʰ UT  H +2.03ʰ  h 58.7°  q +66.3°  rate  +7.5°/h
+	// 13.20ʰ UT  H +2.63ʰ  h 50.4°  q +69.6°  rate  +3.9°/h
+	// 13.80ʰ UT  H +3.23ʰ  h 42.0°  q +71.3°  rate  +2.0°/h
+	// 14.40ʰ UT  H +3.83ʰ  h 33.5°  q +72.1°  rate  +0.7°/h
+	// 15.00ʰ UT  H +4.43ʰ  h 25.1°  q +72.2°  rate  -0.2°/h  max
+	// 15.60ʰ UT  H +5.03ʰ  h 16.6°  q +71.9°  rate  -1.0°/h
+}
+
+func TestParallacticAngleSeriesRate(t *testing.T) {
+	p := globe.Coord{Lat: unit.AngleFromDeg(-30.24), Lon: unit.AngleFromDeg(70.74)}
+	α := unit.NewRA(6, 45, 9)
+	δ := unit.NewAngle('-', 16, 43, 0)
+	s := parallactic.ParallacticAngleSeries(α, δ, 2459000.5, 2459001.5, .001, p)
+	for i := 1; i < len(s)-1; i++ {
+		if s[i].Alt < unit.AngleFromDeg(5) ||
+			math.Abs((s[i+1].Q-s[i-1].Q).Rad()) > 1 {
+			continue
+		}
+		num := (s[i+1].Q - s[i-1].Q).Rad() / (s[i+1].JD - s[i-1].JD)
+		if math.Abs(num-s[i].Rate.Rad()) > 1e-3*math.Max(1, math.Abs(num)) {
+			t.Fatal(i, num, s[i].Rate.Rad())
+		}
+	}
+}
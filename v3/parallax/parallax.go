@@ -26,16 +26,64 @@ func Horizontal(Δ float64) (π unit.Angle) {
 	return hp.Div(Δ) // (40.1) p. 279
 }
 
+// HorizontalKm returns equatorial horizontal parallax of a body at any
+// distance.
+//
+// Argument Δ is distance in km from the center of the Earth.
+//
+// Unlike Horizontal, HorizontalKm does not use a small angle approximation
+// and so is valid for the Moon and for artificial satellites.  The
+// equatorial radius of globe.Earth76 is used.
+func HorizontalKm(Δ float64) (π unit.Angle) {
+	// (40.1) p. 279, without approximating the arcsine.  See also
+	// moonposition.Parallax.
+	return globe.Earth76.Parallax(Δ)
+}
+
 // Topocentric returns topocentric positions including parallax.
 //
 // Arguments α, δ are geocentric right ascension and declination in radians.
 // Δ is distance to the observed object in AU.  ρsφʹ, ρcφʹ are parallax
 // constants (see package globe.) L is geographic longitude of the observer,
-// jde is time of observation.
+// positive west, jde is time of observation.
 //
 // Results are observed topocentric ra and dec in radians.
 func Topocentric(α unit.RA, δ unit.Angle, Δ, ρsφʹ, ρcφʹ float64, L unit.Angle, jde float64) (αʹ unit.RA, δʹ unit.Angle) {
-	π := Horizontal(Δ)
+	return topocentric(α, δ, Horizontal(Δ), ρsφʹ, ρcφʹ, L, jde)
+}
+
+// TopocentricKm returns topocentric positions including parallax for
+// a body at any distance.
+//
+// It is the same as Topocentric except that argument Δ is distance in km
+// and parallax is computed with HorizontalKm.  It is suitable for the Moon
+// and for artificial satellites.
+func TopocentricKm(α unit.RA, δ unit.Angle, Δ, ρsφʹ, ρcφʹ float64, L unit.Angle, jde float64) (αʹ unit.RA, δʹ unit.Angle) {
+	return topocentric(α, δ, HorizontalKm(Δ), ρsφʹ, ρcφʹ, L, jde)
+}
+
+// TopocentricObserver returns topocentric positions including parallax
+// for an observer o.
+//
+// It is the same as Topocentric except that the parallax constants and
+// longitude are taken from o.  Δ is distance to the observed object in AU.
+func TopocentricObserver(α unit.RA, δ unit.Angle, Δ float64, o globe.Observer, jde float64) (αʹ unit.RA, δʹ unit.Angle) {
+	ρsφʹ, ρcφʹ := o.ParallaxConstants()
+	return topocentric(α, δ, Horizontal(Δ), ρsφʹ, ρcφʹ, o.Lon, jde)
+}
+
+// TopocentricObserverKm is the same as TopocentricObserver except that
+// argument Δ is distance in km.
+//
+// Parallax is computed with the equatorial radius of o.Ellipsoid() rather
+// than that of globe.Earth76.
+func TopocentricObserverKm(α unit.RA, δ unit.Angle, Δ float64, o globe.Observer, jde float64) (αʹ unit.RA, δʹ unit.Angle) {
+	e := o.Ellipsoid()
+	ρsφʹ, ρcφʹ := e.ParallaxConstants(o.Lat, o.H)
+	return topocentric(α, δ, e.Parallax(Δ), ρsφʹ, ρcφʹ, o.Lon, jde)
+}
+
+func topocentric(α unit.RA, δ unit.Angle, π unit.Angle, ρsφʹ, ρcφʹ float64, L unit.Angle, jde float64) (αʹ unit.RA, δʹ unit.Angle) {
 	θ0 := sidereal.Apparent(jde)
 	H := (θ0.Angle() - L - unit.Angle(α)).Mod1()
 	sπ := π.Sin()
@@ -71,7 +119,19 @@ func Topocentric2(α unit.RA, δ unit.Angle, Δ, ρsφʹ, ρcφʹ float64, L uni
 // The method should be similarly rigorous to that of Topocentric() and results
 // should be virtually consistent.
 func Topocentric3(α unit.RA, δ unit.Angle, Δ, ρsφʹ, ρcφʹ float64, L unit.Angle, jde float64) (Hʹ unit.HourAngle, δʹ unit.Angle) {
-	π := Horizontal(Δ)
+	return topocentric3(α, δ, Horizontal(Δ), ρsφʹ, ρcφʹ, L, jde)
+}
+
+// Topocentric3Km returns topocentric hour angle and declination including
+// parallax for a body at any distance.
+//
+// It is the same as Topocentric3 except that argument Δ is distance in km
+// and parallax is computed with HorizontalKm.
+func Topocentric3Km(α unit.RA, δ unit.Angle, Δ, ρsφʹ, ρcφʹ float64, L unit.Angle, jde float64) (Hʹ unit.HourAngle, δʹ unit.Angle) {
+	return topocentric3(α, δ, HorizontalKm(Δ), ρsφʹ, ρcφʹ, L, jde)
+}
+
+func topocentric3(α unit.RA, δ unit.Angle, π unit.Angle, ρsφʹ, ρcφʹ float64, L unit.Angle, jde float64) (Hʹ unit.HourAngle, δʹ unit.Angle) {
 	θ0 := sidereal.Apparent(jde)
 	H := (θ0.Angle() - L - unit.Angle(α)).Mod1()
 	sπ := π.Sin()
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/globe"
 	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/meeus/v3/moonposition"
 	"github.com/soniakeys/meeus/v3/parallax"
@@ -53,6 +54,28 @@ func ExampleTopocentric() {
 	// δ' = -15°46′30″.0
 }
 
+func ExampleTopocentricObserver() {
+	// Example 40.a, p. 280
+	o := globe.Observer{
+		Coord: globe.Coord{
+			Lat: unit.NewAngle(' ', 33, 21, 22),
+			Lon: unit.Angle(unit.NewHourAngle(' ', 7, 47, 27)),
+		},
+		H: 1706,
+	}
+	α, δ := parallax.TopocentricObserver(
+		unit.RAFromDeg(339.530208),
+		unit.AngleFromDeg(-15.771083),
+		.37276, o,
+		julian.CalendarGregorianToJD(2003, 8, 28+
+			unit.NewTime(' ', 3, 17, 0).Day()))
+	fmt.Printf("α' = %.2d\n", sexa.FmtRA(α))
+	fmt.Printf("δ' = %.1d\n", sexa.FmtAngle(δ))
+	// Output:
+	// α' = 22ʰ38ᵐ8ˢ.54
+	// δ' = -15°46′30″.0
+}
+
 func ExampleTopocentric2() {
 	// Example 40.a, p. 280
 	Δα, Δδ := parallax.Topocentric2(
@@ -111,3 +134,33 @@ func ExampleTopocentricEcliptical() {
 	// βʹ = +1°29′7.1″
 	// sʹ = 16′25.5″
 }
+
+func ExampleHorizontalKm() {
+	// Example 47.a, p. 342.
+	_, _, Δ := moonposition.Position(julian.CalendarGregorianToJD(1992, 4, 12))
+	fmt.Printf("Δ = %.1f km\n", Δ)
+	fmt.Printf("π = %.6f\n", parallax.HorizontalKm(Δ).Deg())
+	// Output:
+	// Δ = 368409.7 km
+	// π = 0.991990
+}
+
+func TestTopocentricKm(t *testing.T) {
+	// For a distant object, results match Topocentric.
+	α := unit.RAFromDeg(339.530208)
+	δ := unit.AngleFromDeg(-15.771083)
+	Δ := .37276
+	L := unit.Angle(unit.NewHourAngle(' ', 7, 47, 27))
+	jde := julian.CalendarGregorianToJD(2003, 8, 28+
+		unit.NewTime(' ', 3, 17, 0).Day())
+	α1, δ1 := parallax.Topocentric(α, δ, Δ, .546861, .836339, L, jde)
+	α2, δ2 := parallax.TopocentricKm(α, δ, Δ*base.AU, .546861, .836339, L, jde)
+	if math.Abs((α2-α1).Rad()) > 1e-8 || math.Abs((δ2-δ1).Rad()) > 1e-8 {
+		t.Errorf("TopocentricKm = %v %v, Topocentric = %v %v", α2, δ2, α1, δ1)
+	}
+	H1, δ1 := parallax.Topocentric3(α, δ, Δ, .546861, .836339, L, jde)
+	H2, δ2 := parallax.Topocentric3Km(α, δ, Δ*base.AU, .546861, .836339, L, jde)
+	if math.Abs((H2-H1).Rad()) > 1e-8 || math.Abs((δ2-δ1).Rad()) > 1e-8 {
+		t.Errorf("Topocentric3Km = %v %v, Topocentric3 = %v %v", H2, δ2, H1, δ1)
+	}
+}
@@ -7,9 +7,17 @@
 package planetary
 
 import (
+	"errors"
 	"math"
 
+	"github.com/soniakeys/meeus/v3/angle"
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/illum"
+	"github.com/soniakeys/meeus/v3/interp"
+	"github.com/soniakeys/meeus/v3/iterate"
+	pp "github.com/soniakeys/meeus/v3/planetposition"
+	"github.com/soniakeys/meeus/v3/saturnring"
+	"github.com/soniakeys/meeus/v3/semidiameter"
 	"github.com/soniakeys/unit"
 )
 
@@ -163,6 +171,286 @@ func MarsStation2(y float64) (jde float64) {
 	return J + sum(T, M, ms2)
 }
 
+// InfConjunction holds the circumstances of an inferior conjunction as
+// computed by RefineInfConj.
+type InfConjunction struct {
+	JDE    float64    // time of conjunction in geocentric ecliptic longitude
+	Lat    unit.Angle // geocentric ecliptic latitude of the planet at JDE
+	MinJDE float64    // time of least separation from the Sun's center
+	MinSep unit.Angle // least geocentric separation from the Sun's center
+	SunSD  unit.Angle // semidiameter of the Sun at MinJDE
+}
+
+// Transit returns true if the planet passes over the disk of the Sun as
+// seen from the center of the Earth.
+//
+// The test is of the center of the planet; a grazing transit seen only
+// from part of the Earth may not be detected.
+func (c *InfConjunction) Transit() bool {
+	return c.MinSep < c.SunSD
+}
+
+// RefineInfConj computes the circumstances of an inferior conjunction of
+// Mercury or Venus from VSOP87 positions.
+//
+// Argument jde is an approximate time of the conjunction, as returned by
+// MercuryInfConj or VenusInfConj.  Arguments earth and planet must be
+// V87Planet objects for Earth and for Mercury or Venus.
+//
+// Positions are geometric with correction for light time of the planet.
+// Aberration and nutation are ignored as they affect the Sun and the planet
+// nearly equally near conjunction.
+func RefineInfConj(jde float64, earth, planet *pp.V87Planet) (c InfConjunction, err error) {
+	// geocentric ecliptic coordinates of Sun and planet, distance of Sun
+	geo := func(jde float64) (λs, βs, λ, β unit.Angle, R0 float64) {
+		L0, B0, R0 := earth.Position(jde)
+		sB0, cB0 := B0.Sincos()
+		sL0, cL0 := L0.Sincos()
+		var x, y, z float64
+		xyz := func(jde float64) float64 {
+			L, B, R := planet.Position(jde)
+			sB, cB := B.Sincos()
+			sL, cL := L.Sincos()
+			x = R*cB*cL - R0*cB0*cL0
+			y = R*cB*sL - R0*cB0*sL0
+			z = R*sB - R0*sB0
+			return math.Sqrt(x*x + y*y + z*z)
+		}
+		xyz(jde - base.LightTime(xyz(jde)))
+		λ = unit.Angle(math.Atan2(y, x))
+		β = unit.Angle(math.Atan2(z, math.Hypot(x, y)))
+		return L0 + math.Pi, -B0, λ, β, R0
+	}
+	// conjunction in longitude
+	Δλ := func(jde float64) float64 {
+		λs, _, λ, _, _ := geo(jde)
+		return math.Remainder((λ - λs).Rad(), 2*math.Pi)
+	}
+	j1, j2 := jde-2, jde+2
+	if math.Signbit(Δλ(j1)) == math.Signbit(Δλ(j2)) {
+		err = errors.New("No conjunction near jde")
+		return
+	}
+	c.JDE = iterate.BinaryRoot(Δλ, j1, j2)
+	_, _, _, c.Lat, _ = geo(c.JDE)
+	// least separation, found as the extremum of the square of the
+	// separation, which near conjunction is nearly a quadratic in time.
+	sep2 := func(jde float64) float64 {
+		λs, βs, λ, β, _ := geo(jde)
+		x := math.Remainder((λ-λs).Rad(), 2*math.Pi) * ((β + βs) / 2).Cos()
+		y := (β - βs).Rad()
+		return x*x + y*y
+	}
+	t := c.JDE
+	for _, h := range []float64{2, .25} {
+		y := make([]float64, 5)
+		for i := range y {
+			y[i] = sep2(t + float64(i-2)*h/2)
+		}
+		var l5 *interp.Len5
+		if l5, err = interp.NewLen5(t-h, t+h, y); err != nil {
+			return
+		}
+		if t, _, err = l5.Extremum(); err != nil {
+			return
+		}
+	}
+	λs, βs, λ, β, R0 := geo(t)
+	c.MinJDE = t
+	c.MinSep = angle.Sep(λs, βs, λ, β)
+	c.SunSD = semidiameter.Semidiameter(semidiameter.Sun, R0)
+	return
+}
+
+// Planet constants for argument planet of Next.
+const (
+	Mercury = iota
+	Venus
+	Earth
+	Mars
+	Jupiter
+	Saturn
+	Uranus
+	Neptune
+)
+
+// Kind identifies a planetary phenomenon for Next.
+type Kind int
+
+// Phenomena that can be passed to Next.
+const (
+	InfConj        Kind = iota // inferior conjunction
+	SupConj                    // superior conjunction
+	Conj                       // conjunction of a superior planet
+	Opp                        // opposition
+	EastElongation             // greatest eastern elongation
+	WestElongation             // greatest western elongation
+	Station2                   // second station
+)
+
+// ErrorNotAvailable is returned for a combination of phenomenon and planet
+// that has no function in this package.
+var ErrorNotAvailable = errors.New("phenomenon not available for planet")
+
+// ErrorPlanet is returned by Circumstances for an invalid planet.
+var ErrorPlanet = errors.New("invalid planet")
+
+// phenomenon returns the function computing event kind for planet p,
+// and the coefficients giving its mean period.  It returns nil for
+// combinations not available.
+func phenomenon(kind Kind, p int) (func(float64) float64, *ca) {
+	elong := func(f func(float64) (float64, unit.Angle)) func(float64) float64 {
+		return func(y float64) float64 {
+			jde, _ := f(y)
+			return jde
+		}
+	}
+	switch {
+	case p == Mercury && kind == InfConj:
+		return MercuryInfConj, micA
+	case p == Mercury && kind == SupConj:
+		return MercurySupConj, mscA
+	case p == Mercury && kind == EastElongation:
+		return elong(MercuryEastElongation), micA
+	case p == Mercury && kind == WestElongation:
+		return elong(MercuryWestElongation), micA
+	case p == Venus && kind == InfConj:
+		return VenusInfConj, vicA
+	case p == Mars && kind == Opp:
+		return MarsOpp, moA
+	case p == Mars && kind == Station2:
+		return MarsStation2, moA
+	case p == Jupiter && kind == Opp:
+		return JupiterOpp, joA
+	case p == Saturn && kind == Opp:
+		return SaturnOpp, soA
+	case p == Saturn && kind == Conj:
+		return SaturnConj, scA
+	case p == Uranus && kind == Opp:
+		return UranusOpp, uoA
+	case p == Neptune && kind == Opp:
+		return NeptuneOpp, noA
+	}
+	return nil, nil
+}
+
+// Next returns the time of the first occurrence of a phenomenon strictly
+// after a given time.
+//
+// Argument kind is one of the Kind constants above, planet is one of the
+// planet constants above, and jde is the time after which to search.
+//
+// Only the combinations of phenomenon and planet that have functions in
+// this package are supported.  Next returns ErrorNotAvailable for other
+// combinations.
+func Next(kind Kind, planet int, jde float64) (float64, error) {
+	f, a := phenomenon(kind, planet)
+	if f == nil {
+		return 0, ErrorNotAvailable
+	}
+	// Evaluate f at mean times of the phenomenon, where the k of (36.1) is
+	// unambiguous.  Corrections to the mean times are small compared to the
+	// period so the event one period before the mean time at or before jde
+	// is certainly not after jde.
+	at := func(k float64) float64 {
+		return f((a.A + k*a.B - 1721060) / 365.2425)
+	}
+	k := math.Floor((jde-a.A)/a.B) - 1
+	for at(k) > jde {
+		k--
+	}
+	for {
+		k++
+		if j := at(k); j > jde {
+			return j, nil
+		}
+	}
+}
+
+// Event holds a planetary phenomenon with the circumstances of the planet
+// at the time of the phenomenon, as returned by NextEvent.
+type Event struct {
+	Kind     Kind
+	Planet   int
+	JDE      float64    // time of the phenomenon
+	R, Δ     float64    // distances of the planet from the Sun and Earth, AU
+	Mag      float64    // visual magnitude
+	Diameter unit.Angle // apparent equatorial diameter
+}
+
+// NextEvent returns the first occurrence of a phenomenon strictly after a
+// given time, with the magnitude and apparent diameter of the planet at
+// that time.
+//
+// Arguments kind, planet, and jde are as for Next.  Arguments earth and pl
+// must be V87Planet objects for Earth and the planet.  Magnitude and
+// diameter are computed as described for Circumstances.  Errors are as for
+// Next and Circumstances.
+func NextEvent(kind Kind, planet int, jde float64, earth, pl *pp.V87Planet) (e Event, err error) {
+	e = Event{Kind: kind, Planet: planet}
+	if e.JDE, err = Next(kind, planet, jde); err != nil {
+		return
+	}
+	e.R, e.Δ, e.Mag, e.Diameter, err = Circumstances(planet, e.JDE, earth, pl)
+	return
+}
+
+// Circumstances returns the distances, visual magnitude, and apparent
+// equatorial diameter of a planet at time jde.
+//
+// Argument planet is one of the planet constants above, other than Earth.
+// Arguments earth and pl must be V87Planet objects for Earth and the planet.
+//
+// Results r and Δ are the distances of the planet from the Sun and Earth in
+// AU, corrected for light time.  Magnitudes are those of chapter 41 as
+// computed by package illum.  For Saturn the magnitude includes the rings,
+// using the ring geometry of package saturnring.  The diameter is twice the
+// semidiameter of chapter 55, using the cloud top value for Venus.
+//
+// ErrorPlanet is returned for Earth or a value that is not a planet
+// constant.
+func Circumstances(planet int, jde float64, earth, pl *pp.V87Planet) (r, Δ, mag float64, diameter unit.Angle, err error) {
+	if planet < Mercury || planet > Neptune || planet == Earth {
+		err = ErrorPlanet
+		return
+	}
+	L0, B0, R0 := earth.Position(jde)
+	sB0, cB0 := B0.Sincos()
+	sL0, cL0 := L0.Sincos()
+	dist := func(jde float64) float64 {
+		var L, B unit.Angle
+		L, B, r = pl.Position(jde)
+		sB, cB := B.Sincos()
+		sL, cL := L.Sincos()
+		x := r*cB*cL - R0*cB0*cL0
+		y := r*cB*sL - R0*cB0*sL0
+		z := r*sB - R0*sB0
+		return math.Sqrt(x*x + y*y + z*z)
+	}
+	Δ = dist(jde - base.LightTime(dist(jde)))
+	i := illum.PhaseAngle(r, Δ, R0)
+	var s0 unit.Angle
+	switch planet {
+	case Mercury:
+		mag, s0 = illum.Mercury(r, Δ, i), semidiameter.Mercury
+	case Venus:
+		mag, s0 = illum.Venus(r, Δ, i), semidiameter.VenusCloud
+	case Mars:
+		mag, s0 = illum.Mars(r, Δ, i), semidiameter.Mars
+	case Jupiter:
+		mag, s0 = illum.Jupiter(r, Δ), semidiameter.JupiterEquatorial
+	case Saturn:
+		ΔU, B := saturnring.UB(jde, earth, pl)
+		mag, s0 = illum.Saturn(r, Δ, B, ΔU), semidiameter.SaturnEquatorial
+	case Uranus:
+		mag, s0 = illum.Uranus(r, Δ), semidiameter.Uranus
+	case Neptune:
+		mag, s0 = illum.Neptune(r, Δ), semidiameter.Neptune
+	}
+	diameter = 2 * semidiameter.Semidiameter(s0, Δ)
+	return
+}
+
 // ca holds coefficients from one line of table 36.A, p. 250
 type ca struct {
 	A, B, M0, M1 float64
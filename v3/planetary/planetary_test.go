@@ -91,3 +91,64 @@ func Test255(t *testing.T) {
 		}
 	}
 }
+
+func ExampleNext() {
+	// Example 36.a, p. 252 finds the inferior conjunction of Mercury
+	// on 1993 November 6.
+	j, err := planetary.Next(planetary.InfConj, planetary.Mercury,
+		julian.CalendarGregorianToJD(1993, 10, 1))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.3f\n", j)
+	// The next one after that.
+	j, err = planetary.Next(planetary.InfConj, planetary.Mercury, j)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	y, m, df := julian.JDToCalendar(j)
+	fmt.Printf("%d %s %d\n", y, time.Month(m), int(df))
+	// Output:
+	// 2449297.645
+	// 1994 February 20
+}
+
+func TestNext(t *testing.T) {
+	for _, d := range []struct {
+		kind   planetary.Kind
+		planet int
+		f      func(float64) float64
+	}{
+		{planetary.SupConj, planetary.Mercury, planetary.MercurySupConj},
+		{planetary.InfConj, planetary.Venus, planetary.VenusInfConj},
+		{planetary.Opp, planetary.Mars, planetary.MarsOpp},
+		{planetary.Station2, planetary.Mars, planetary.MarsStation2},
+		{planetary.Opp, planetary.Jupiter, planetary.JupiterOpp},
+		{planetary.Conj, planetary.Saturn, planetary.SaturnConj},
+		{planetary.Opp, planetary.Neptune, planetary.NeptuneOpp},
+	} {
+		// the event nearest a year, and the time just before and at it
+		e := d.f(2125.5)
+		if j, err := planetary.Next(d.kind, d.planet, e-.01); err != nil || j != e {
+			t.Errorf("planet %d kind %d: got %.3f, expected %.3f",
+				d.planet, d.kind, j, e)
+		}
+		if j, err := planetary.Next(d.kind, d.planet, e); err != nil || j <= e {
+			t.Errorf("planet %d kind %d: got %.3f, expected after %.3f",
+				d.planet, d.kind, j, e)
+		}
+	}
+	if _, err := planetary.Next(planetary.Opp, planetary.Venus, 2451545); err != planetary.ErrorNotAvailable {
+		t.Error("Venus opposition: got", err)
+	}
+}
+
+func TestCircumstancesPlanet(t *testing.T) {
+	for _, p := range []int{planetary.Earth, -1, planetary.Neptune + 1} {
+		if _, _, _, _, err := planetary.Circumstances(p, 2451545, nil, nil); err != planetary.ErrorPlanet {
+			t.Errorf("planet %d: got %v", p, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+// +build !nopp
+
+package planetary_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/planetary"
+	pp "github.com/soniakeys/meeus/v3/planetposition"
+)
+
+func ExampleRefineInfConj() {
+	// Inferior conjunctions with transits of Venus in 2012 and Mercury
+	// in 2016 and 2019.
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	venus, err := pp.LoadPlanet(pp.Venus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	mercury, err := pp.LoadPlanet(pp.Mercury)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, c := range []struct {
+		name string
+		jde  float64
+		pl   *pp.V87Planet
+	}{
+		{"Venus", planetary.VenusInfConj(2012.4), venus},
+		{"Mercury", planetary.MercuryInfConj(2016.35), mercury},
+		{"Mercury", planetary.MercuryInfConj(2019.85), mercury},
+	} {
+		ic, err := planetary.RefineInfConj(c.jde, earth, c.pl)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		y, m, d := julian.JDToCalendar(ic.JDE)
+		fmt.Printf("%-7s %d %d %2d  least separation %.1f′  transit: %t\n",
+			c.name, y, m, int(d), ic.MinSep.Min(), ic.Transit())
+	}
+	// Output:
+	// Venus   2012 6  6  least separation 9.2′  transit: true
+	// Mercury 2016 5  9  least separation 5.3′  transit: true
+	// Mercury 2019 11 11  least separation 1.3′  transit: true
+}
+
+func ExampleNextEvent() {
+	// Oppositions of Mars, Jupiter, and Saturn following 2020 January 1.
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	jde := julian.CalendarGregorianToJD(2020, 1, 1)
+	for _, p := range []struct {
+		name   string
+		planet int
+		ppNum  int
+	}{
+		{"Mars", planetary.Mars, pp.Mars},
+		{"Jupiter", planetary.Jupiter, pp.Jupiter},
+		{"Saturn", planetary.Saturn, pp.Saturn},
+	} {
+		pl, err := pp.LoadPlanet(p.ppNum)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		e, err := planetary.NextEvent(planetary.Opp, p.planet, jde, earth, pl)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		y, m, d := julian.JDToCalendar(e.JDE)
+		fmt.Printf("%-7s %d %d %2d  Δ %.1f AU  mag %+.1f  diameter %.0f″\n",
+			p.name, y, m, int(d), e.Δ, e.Mag, e.Diameter.Sec())
+	}
+	// Output:
+	// Mars    2020 10 13  Δ 0.4 AU  mag -2.4  diameter 22″
+	// Jupiter 2020 7 14  Δ 4.1 AU  mag -2.3  diameter 48″
+	// Saturn  2020 7 20  Δ 9.0 AU  mag +0.3  diameter 18″
+}
+
+func TestRefineInfConj(t *testing.T) {
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		t.Skip(err)
+	}
+	venus, err := pp.LoadPlanet(pp.Venus)
+	if err != nil {
+		t.Skip(err)
+	}
+	// no transit of Venus between 2012 and 2117
+	ic, err := planetary.RefineInfConj(planetary.VenusInfConj(2020.4), earth, venus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ic.Transit() {
+		t.Fatal("transit in 2020")
+	}
+	// least separation cannot exceed separation at conjunction
+	if ic.MinSep.Rad() > math.Abs(ic.Lat.Rad())+1e-5 {
+		t.Fatal(ic.MinSep.Deg(), ic.Lat.Deg())
+	}
+}
@@ -7,7 +7,12 @@
 package planetelements
 
 import (
+	"math"
+
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/elementequinox"
+	pp "github.com/soniakeys/meeus/v3/planetposition"
+	"github.com/soniakeys/meeus/v3/precess"
 	"github.com/soniakeys/unit"
 )
 
@@ -145,3 +150,61 @@ func Inc(p int, jde float64) unit.Angle {
 func Node(p int, jde float64) unit.Angle {
 	return unit.AngleFromDeg(base.Horner(base.J2000Century(jde), cMean[p].Ω...))
 }
+
+// reciprocal masses of the planets, IAU 1976.  Earth includes the Moon.
+var recipMass = [nPlanets]float64{6023600, 408523.5, 328900.5, 3098710,
+	1047.355, 3498.5, 22869, 19314}
+
+// Osculating computes osculating elements for a planet at a date.
+//
+// Argument p must be a planet const as defined above, v a V87Planet object
+// for the same planet.  Argument e is a result parameter as with function
+// Mean.
+//
+// Elements are computed from the heliocentric position and velocity of
+// the full VSOP87 theory and the masses of the Sun and planet.  For dates
+// near jde they describe the motion of the planet more accurately than
+// the mean elements of function Mean.
+//
+// Results are referenced to mean dynamical ecliptic and equinox of date,
+// as with Mean.
+func Osculating(p int, v *pp.V87Planet, jde float64, e *Elements) {
+	L, B, R := v.Position2000(jde)
+	dL, dB, dR := v.Velocity(jde)
+	sL, cL := L.Sincos()
+	sB, cB := B.Sincos()
+	// heliocentric rectangular position and velocity, J2000 ecliptic
+	x, y, z := R*cB*cL, R*cB*sL, R*sB
+	vx := dR*cB*cL - R*sB*cL*dB.Rad() - R*cB*sL*dL.Rad()
+	vy := dR*cB*sL - R*sB*sL*dB.Rad() + R*cB*cL*dL.Rad()
+	vz := dR*sB + R*cB*dB.Rad()
+	μ := base.K * base.K * (1 + 1/recipMass[p])
+	// angular momentum
+	hx := y*vz - z*vy
+	hy := z*vx - x*vz
+	hz := x*vy - y*vx
+	h := math.Sqrt(hx*hx + hy*hy + hz*hz)
+	v2 := vx*vx + vy*vy + vz*vz
+	e.Axis = 1 / (2/R - v2/μ)
+	// eccentricity vector
+	ex := (vy*hz-vz*hy)/μ - x/R
+	ey := (vz*hx-vx*hz)/μ - y/R
+	ez := (vx*hy-vy*hx)/μ - z/R
+	e.Ecc = math.Sqrt(ex*ex + ey*ey + ez*ez)
+	i := unit.Angle(math.Acos(hz / h))
+	Ω := unit.Angle(math.Atan2(hx, -hy))
+	sΩ, cΩ := Ω.Sincos()
+	ω := unit.Angle(math.Atan2(ez/i.Sin(), ex*cΩ+ey*sΩ))
+	// mean anomaly from eccentric anomaly
+	rv := x*vx + y*vy + z*vz
+	E := math.Atan2(rv/math.Sqrt(μ*e.Axis), 1-R/e.Axis)
+	M := unit.Angle(E - e.Ecc*math.Sin(E))
+	// reduce to equinox of date
+	ep := precess.NewEclipticPrecessor(2000, base.JDEToJulianYear(jde))
+	var d elementequinox.Elements
+	ep.ReduceElements(&elementequinox.Elements{Inc: i, Peri: ω, Node: Ω}, &d)
+	e.Inc = d.Inc
+	e.Node = d.Node.Mod1()
+	e.Peri = (d.Node + d.Peri).Mod1()
+	e.Lon = (e.Peri + M).Mod1()
+}
@@ -0,0 +1,35 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+// +build !nopp
+
+package planetelements_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/soniakeys/meeus/v3/julian"
+	pe "github.com/soniakeys/meeus/v3/planetelements"
+	pp "github.com/soniakeys/meeus/v3/planetposition"
+)
+
+// Osculating elements should be near the mean elements.
+func TestOsculating(t *testing.T) {
+	v, err := pp.LoadPlanet(pp.Mercury)
+	if err != nil {
+		t.Skip(err)
+	}
+	j := julian.CalendarGregorianToJD(2065, 6, 24)
+	var m, o pe.Elements
+	pe.Mean(pe.Mercury, j, &m)
+	pe.Osculating(pe.Mercury, v, j, &o)
+	if math.Abs(o.Axis-m.Axis) > 1e-4 ||
+		math.Abs(o.Ecc-m.Ecc) > 1e-3 ||
+		math.Abs((o.Inc-m.Inc).Deg()) > .01 ||
+		math.Abs(math.Remainder((o.Node-m.Node).Rad(), 2*math.Pi)) > .01 ||
+		math.Abs(math.Remainder((o.Peri-m.Peri).Rad(), 2*math.Pi)) > .01 ||
+		math.Abs(math.Remainder((o.Lon-m.Lon).Rad(), 2*math.Pi)) > .01 {
+		t.Errorf("mean %+v\nosculating %+v", m, o)
+	}
+}
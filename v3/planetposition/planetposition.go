@@ -191,30 +191,84 @@ func (c *coeff) parse(ic byte, ibody int, lines []string, n int, au bool) (int,
 //
 // Results are for the dynamical equinox and ecliptic J2000.
 //
-//	L is heliocentric longitude.
+//	L is heliocentric longitude, in the range [0, 2π).
 //	B is heliocentric latitude.
 //	R is heliocentric range in AU.
 func (vt *V87Planet) Position2000(jde float64) (L, B unit.Angle, R float64) {
-	T := base.J2000Century(jde)
-	τ := T * .1
-	cf := make([]float64, 6)
-	sum := func(series coeff) float64 {
-		for x, terms := range series {
-			cf[x] = 0
-			// sum terms in reverse order to preserve accuracy
-			for y := len(terms) - 1; y >= 0; y-- {
-				term := &terms[y]
-				cf[x] += term.a * math.Cos(term.b+term.c*τ)
-			}
+	τ := base.J2000Century(jde) * .1
+	L = unit.Angle(unit.PMod(vt.l.sum(τ), 2*math.Pi))
+	B = unit.Angle(vt.b.sum(τ))
+	R = vt.r.sum(τ)
+	return
+}
+
+// sum evaluates a series at τ, in Julian millennia from J2000.
+//
+// Coefficients are accumulated in a fixed size array so that evaluation
+// does not allocate.
+func (c *coeff) sum(τ float64) float64 {
+	var cf [6]float64
+	for x, terms := range c {
+		// sum terms in reverse order to preserve accuracy
+		for y := len(terms) - 1; y >= 0; y-- {
+			term := &terms[y]
+			cf[x] += term.a * math.Cos(term.b+term.c*τ)
 		}
-		return base.Horner(τ, cf[:len(series)]...)
 	}
-	L = unit.Angle(unit.PMod(sum(vt.l), 2*math.Pi))
-	B = unit.Angle(sum(vt.b))
-	R = sum(vt.r)
+	return horner(τ, &cf)
+}
+
+// horner evaluates the polynomial with coefficients cf at τ.  It is
+// base.Horner for a fixed size array, avoiding the variadic slice.
+func horner(τ float64, cf *[6]float64) float64 {
+	y := cf[5]
+	for i := 4; i >= 0; i-- {
+		y = y*τ + cf[i]
+	}
+	return y
+}
+
+// Velocity returns rates of change of the heliocentric ecliptic
+// coordinates of Position2000.
+//
+// Argument jde is the date for which velocities are desired.
+//
+// Results are found by differentiating the VSOP87 series term by term and
+// are for the dynamical equinox and ecliptic J2000.
+//
+//	dL is the rate of change of heliocentric longitude, per day.
+//	dB is the rate of change of heliocentric latitude, per day.
+//	dR is the rate of change of heliocentric range in AU per day.
+func (vt *V87Planet) Velocity(jde float64) (dL, dB unit.Angle, dR float64) {
+	τ := base.J2000Century(jde) * .1
+	// τ is in Julian millennia
+	const d = 1 / (base.JulianCentury * 10.)
+	dL = unit.Angle(vt.l.dsum(τ) * d)
+	dB = unit.Angle(vt.b.dsum(τ) * d)
+	dR = vt.r.dsum(τ) * d
 	return
 }
 
+// dsum evaluates the derivative of a series with respect to τ.
+func (c *coeff) dsum(τ float64) float64 {
+	var cf, df [6]float64
+	// The series is Σ τ^α S_α(τ) where S_α is a sum of a*cos(b + c*τ).
+	// The derivative with respect to τ is Σ τ^α (S_α' + (α+1) S_α+1).
+	for x, terms := range c {
+		// sum terms in reverse order to preserve accuracy
+		for y := len(terms) - 1; y >= 0; y-- {
+			term := &terms[y]
+			s, c := math.Sincos(term.b + term.c*τ)
+			cf[x] += term.a * c
+			df[x] -= term.a * term.c * s
+		}
+	}
+	for x := 1; x < len(cf); x++ {
+		df[x-1] += float64(x) * cf[x]
+	}
+	return horner(τ, &df)
+}
+
 // Position returns ecliptic position of planets at equinox and ecliptic of date.
 //
 // Argument jde is the date for which positions are desired.
@@ -222,7 +276,7 @@ func (vt *V87Planet) Position2000(jde float64) (L, B unit.Angle, R float64) {
 // Results are positions consistent with those from Meeus's Apendix III,
 // that is, at equinox and ecliptic of date.
 //
-//  L is heliocentric longitude.
+//  L is heliocentric longitude, in the range [0, 2π).
 //  B is heliocentric latitude.
 //  R is heliocentric range in AU.
 func (vt *V87Planet) Position(jde float64) (L, B unit.Angle, R float64) {
@@ -235,17 +289,42 @@ func (vt *V87Planet) Position(jde float64) (L, B unit.Angle, R float64) {
 	epochFrom := 2000.0
 	epochTo := base.JDEToJulianYear(jde)
 	precess.EclipticPosition(eclFrom, eclTo, epochFrom, epochTo, 0, 0)
-	return eclTo.Lon, eclTo.Lat, R
+	return eclTo.Lon.Mod1(), eclTo.Lat, R
 }
 
 // ToFK5 converts ecliptic longitude and latitude from dynamical frame to FK5.
+//
+// Result L5 is in the range [0, 2π).
 func ToFK5(L, B unit.Angle, jde float64) (L5, B5 unit.Angle) {
 	// formula 32.3, p. 219.
 	T := base.J2000Century(jde)
 	Lp := L - unit.AngleFromDeg(1.397*T+.00031*T*T)
 	sLp, cLp := Lp.Sincos()
 	// (32.3) p. 219
-	L5 = L + unit.AngleFromSec(-.09033+.03916*(cLp+sLp)*B.Tan())
+	L5 = (L + unit.AngleFromSec(-.09033+.03916*(cLp+sLp)*B.Tan())).Mod1()
 	B5 = B + unit.AngleFromSec(.03916*(cLp-sLp))
 	return
 }
+
+// Spherical holds a heliocentric ecliptic position as returned by Position.
+type Spherical struct {
+	L, B unit.Angle // heliocentric longitude and latitude
+	R    float64    // heliocentric range in AU
+}
+
+// All returns positions of all loaded planets for a single jde.
+//
+// Argument planets is indexed by the planet constants Mercury through
+// Neptune.  Nil entries are skipped and leave the corresponding result as
+// the zero Spherical.
+//
+// Results are as for Position, at equinox and ecliptic of date.
+func All(jde float64, planets *[8]*V87Planet) (pos [8]Spherical) {
+	for i, p := range planets {
+		if p != nil {
+			s := &pos[i]
+			s.L, s.B, s.R = p.Position(jde)
+		}
+	}
+	return
+}
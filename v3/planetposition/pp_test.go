@@ -7,8 +7,10 @@ package planetposition_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
+	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/julian"
 	pp "github.com/soniakeys/meeus/v3/planetposition"
 	"github.com/soniakeys/sexagesimal"
@@ -51,6 +53,30 @@ func ExampleV87Planet_Position() {
 	// R = 0.724602 AU
 }
 
+func ExampleAll() {
+	// Example 32.a, p. 219, with only Venus and Earth loaded.
+	jd := julian.CalendarGregorianToJD(1992, 12, 20)
+	var planets [8]*pp.V87Planet
+	for _, i := range []int{pp.Venus, pp.Earth} {
+		p, err := pp.LoadPlanet(i)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		planets[i] = p
+	}
+	for i, s := range pp.All(jd, &planets) {
+		if planets[i] == nil {
+			continue
+		}
+		fmt.Printf("%d  L = %+.5j  B = %+.5j  R = %.6f AU\n",
+			i, sexa.FmtAngle(s.L), sexa.FmtAngle(s.B), s.R)
+	}
+	// Output:
+	// 1  L = +26°.11412  B = -2°.62060  R = 0.724602 AU
+	// 2  L = +88°.35704  B = +°.00014  R = 0.983824 AU
+}
+
 func ExampleToFK5() {
 	// In example 33.a, p. 226
 	jd := 2448976.5
@@ -94,3 +120,68 @@ func TestFK5(t *testing.T) {
 		t.Error(Δβ)
 	}
 }
+
+func TestVelocity(t *testing.T) {
+	p, err := pp.LoadPlanet(pp.Mars)
+	if err != nil {
+		t.Skip(err)
+	}
+	// compare to numerical differentiation of Position2000
+	jd := julian.CalendarGregorianToJD(1992, 12, 20)
+	const h = .01
+	l1, b1, r1 := p.Position2000(jd - h)
+	l2, b2, r2 := p.Position2000(jd + h)
+	dL, dB, dR := p.Velocity(jd)
+	if d := (l2-l1).Rad()/(2*h) - dL.Rad(); math.Abs(d) > 1e-9 {
+		t.Errorf("dL = %g, differs from numerical by %g", dL, d)
+	}
+	if d := (b2-b1).Rad()/(2*h) - dB.Rad(); math.Abs(d) > 1e-9 {
+		t.Errorf("dB = %g, differs from numerical by %g", dB, d)
+	}
+	if d := (r2-r1)/(2*h) - dR; math.Abs(d) > 1e-9 {
+		t.Errorf("dR = %g, differs from numerical by %g", dR, d)
+	}
+}
+
+func BenchmarkPosition2000(b *testing.B) {
+	p, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		b.Skip(err)
+	}
+	jde := julian.CalendarGregorianToJD(1992, 12, 20)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Position2000(jde)
+	}
+}
+
+func TestPosition2000Allocs(t *testing.T) {
+	p, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		t.Skip(err)
+	}
+	jde := julian.CalendarGregorianToJD(1992, 12, 20)
+	if n := testing.AllocsPerRun(10, func() { p.Position2000(jde) }); n != 0 {
+		t.Fatal(n, "allocations")
+	}
+}
+
+func TestLongitudeRange(t *testing.T) {
+	// FK5 correction of -0.09″ must not leave a negative longitude.
+	L5, _ := pp.ToFK5(unit.AngleFromSec(.01), 0, base.J2000)
+	if L5 < 0 || L5.Rad() >= 2*math.Pi {
+		t.Fatal(L5)
+	}
+	p, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		t.Skip(err)
+	}
+	for jd := 2415020.; jd < 2488070; jd += 9.3 {
+		L0, _, _ := p.Position2000(jd)
+		L, _, _ := p.Position(jd)
+		if L0 < 0 || L0.Rad() >= 2*math.Pi || L < 0 || L.Rad() >= 2*math.Pi {
+			t.Fatal(jd, L0, L)
+		}
+	}
+}
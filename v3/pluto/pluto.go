@@ -35,23 +35,37 @@ func Heliocentric(jde float64) (l, b unit.Angle, r float64) {
 	return
 }
 
+// XYZ returns J2000 heliocentric equatorial rectangular coordinates of Pluto.
+//
+// Results x, y, z are in AU.  The function has the form expected by
+// elliptic.AstrometricJ2000.
+func XYZ(jde float64) (x, y, z float64) {
+	const sε, cε = base.SOblJ2000, base.COblJ2000
+	l, b, r := Heliocentric(jde)
+	sl, cl := l.Sincos()
+	sb, cb := b.Sincos()
+	// (37.1) p. 264
+	x = r * cl * cb
+	y = r * (sl*cb*cε - sb*sε)
+	z = r * (sl*cb*sε + sb*cε)
+	return
+}
+
 // Astrometric returns J2000 astrometric coordinates of Pluto.
 func Astrometric(jde float64, e *pp.V87Planet) (α unit.RA, δ unit.Angle) {
-	const sε, cε = base.SOblJ2000, base.COblJ2000
-	f := func(jde float64) (x, y, z float64) {
-		l, b, r := Heliocentric(jde)
-		sl, cl := l.Sincos()
-		sb, cb := b.Sincos()
-		// (37.1) p. 264
-		x = r * cl * cb
-		y = r * (sl*cb*cε - sb*sε)
-		z = r * (sl*cb*sε + sb*cε)
-		return
-	}
-	α, δ, _ = elliptic.AstrometricJ2000(f, jde, e)
+	α, δ, _ = AstrometricElongation(jde, e)
 	return
 }
 
+// AstrometricElongation returns J2000 astrometric coordinates of Pluto
+// along with its elongation.
+//
+// The position is corrected for light time as with other bodies computed
+// by elliptic.AstrometricJ2000.
+func AstrometricElongation(jde float64, e *pp.V87Planet) (α unit.RA, δ, ψ unit.Angle) {
+	return elliptic.AstrometricJ2000(XYZ, jde, e)
+}
+
 func init() {
 	for i := range t37 {
 		t := &t37[i]
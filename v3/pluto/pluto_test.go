@@ -5,6 +5,7 @@ package pluto_test
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/soniakeys/meeus/v3/pluto"
 )
@@ -20,3 +21,17 @@ func ExampleHeliocentric() {
 	// b: 14.58782
 	// r: 29.711111
 }
+
+func ExampleXYZ() {
+	// Example 37.a, p. 266
+	x, y, z := pluto.XYZ(2448908.5)
+	fmt.Printf("x: %.6f\n", x)
+	fmt.Printf("y: %.6f\n", y)
+	fmt.Printf("z: %.6f\n", z)
+	fmt.Printf("r: %.6f\n", math.Sqrt(x*x+y*y+z*z))
+	// Output:
+	// x: -17.407914
+	// y: -23.973080
+	// z: -2.237424
+	// r: 29.711111
+}
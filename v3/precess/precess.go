@@ -169,6 +169,49 @@ func (p *Precessor) Precess(eqFrom, eqTo *coord.Equatorial) *coord.Equatorial {
 	return eqTo
 }
 
+// Matrix returns the rotation matrix of the precession.
+//
+// The matrix transforms equatorial rectangular coordinates referenced to
+// epochFrom to coordinates referenced to epochTo.  It is equivalent to
+// formula (21.4) p. 134.
+func (p *Precessor) Matrix() [3][3]float64 {
+	sζ, cζ := p.ζ.Sincos()
+	sz, cz := p.z.Sincos()
+	sθ, cθ := p.sθ, p.cθ
+	return [3][3]float64{
+		{cζ*cz*cθ - sζ*sz, -sζ*cz*cθ - cζ*sz, -cz * sθ},
+		{cζ*sz*cθ + sζ*cz, -sζ*sz*cθ + cζ*cz, -sz * sθ},
+		{cζ * sθ, -sζ * sθ, cθ},
+	}
+}
+
+// PrecessXYZ precesses a slice of equatorial rectangular coordinates in place.
+//
+// Vectors need not be unit vectors.  The rotation matrix is computed once
+// for the slice so that no trigonometric functions are evaluated per vector.
+func (p *Precessor) PrecessXYZ(v [][3]float64) {
+	m := p.Matrix()
+	for i, r := range v {
+		v[i] = [3]float64{
+			m[0][0]*r[0] + m[0][1]*r[1] + m[0][2]*r[2],
+			m[1][0]*r[0] + m[1][1]*r[1] + m[1][2]*r[2],
+			m[2][0]*r[0] + m[2][1]*r[1] + m[2][2]*r[2],
+		}
+	}
+}
+
+// ToEpoch precesses coordinates eqFrom to epoch, leaving result in eqTo.
+//
+// The epoch of eqFrom is taken from eqFrom.Epoch; eqTo.Epoch is set to
+// epoch.  The same struct may be used for eqFrom and eqTo.  EqTo is
+// returned for convenience.
+func ToEpoch(eqFrom, eqTo *coord.EquatorialEpoch, epoch float64) *coord.EquatorialEpoch {
+	NewPrecessor(eqFrom.Epoch, epoch).Precess(&eqFrom.Equatorial,
+		&eqTo.Equatorial)
+	eqTo.Epoch = epoch
+	return eqTo
+}
+
 // Position precesses equatorial coordinates from one epoch to another,
 // including proper motions.
 //
@@ -282,6 +325,46 @@ func (p *EclipticPrecessor) ReduceElements(eFrom, eTo *elementequinox.Elements)
 	return eTo
 }
 
+// ReduceElementsStepped reduces orbital elements of a solar system body from
+// one equinox to another in a series of steps of no more than 100 years.
+//
+// Each step is a reduction by EclipticPrecessor.ReduceElements, so the
+// expressions (21.5) and (21.6) are evaluated only over intervals of 100
+// years or less.
+//
+// Stepping does not make the reduction more accurate.  For the elements of
+// example 24.a reduced from J2000, the stepped and single reductions differ
+// from each other by at most 0.004″ at the year 1000, 0.07″ at the year 0,
+// 0.33″ at -1000, and 2.7″ at -3000.  Both differ much more from a rigorous
+// reduction, made by rotating the orbit to the equator, precessing it with
+// the product of Precessor matrices for steps of 100 years, and rotating it
+// back to the ecliptic:  by up to 0.2″ at 1000 and at 3000, 3.4″ at 0, 18″
+// at -1000, and 140″ at -3000.  Over the span -3000 to 3000 the single
+// reduction is as close as or closer than the stepped one in node and
+// perihelion, so EclipticPrecessor.ReduceElements should generally be
+// preferred.  ReduceElementsStepped is useful for reproducing results
+// computed in steps.
+//
+// Epochs are Julian years.  Both eFrom and eTo must be non-nil, although
+// they may point to the same struct.  ETo is returned for convenience.
+func ReduceElementsStepped(eFrom, eTo *elementequinox.Elements, epochFrom, epochTo float64) *elementequinox.Elements {
+	const step = 100
+	*eTo = *eFrom
+	for epochFrom != epochTo {
+		e := epochTo
+		if e-epochFrom > step {
+			e = epochFrom + step
+		} else if e-epochFrom < -step {
+			e = epochFrom - step
+		}
+		NewEclipticPrecessor(epochFrom, e).ReduceElements(eTo, eTo)
+		epochFrom = e
+	}
+	eTo.Node = eTo.Node.Mod1()
+	eTo.Peri = eTo.Peri.Mod1()
+	return eTo
+}
+
 // EclipticPosition precesses ecliptic coordinates from one epoch to another,
 // including proper motions.
 //
@@ -325,16 +408,7 @@ func eqProperMotionToEcl(mα unit.HourAngle, mδ unit.Angle, epoch float64, pos
 // Both eqFrom and eqTo must be non-nil, although they may point to the same
 // struct.  EqTo is returned for convenience.
 func ProperMotion3D(eqFrom, eqTo *coord.Equatorial, epochFrom, epochTo, r, mr float64, mα unit.HourAngle, mδ unit.Angle) *coord.Equatorial {
-	sα, cα := eqFrom.RA.Sincos()
-	sδ, cδ := eqFrom.Dec.Sincos()
-	x := r * cδ * cα
-	y := r * cδ * sα
-	z := r * sδ
-	mrr := mr / r
-	zmδ := z * mδ.Rad()
-	mx := x*mrr - zmδ*cα - y*mα.Rad()
-	my := y*mrr - zmδ*sα + x*mα.Rad()
-	mz := z*mrr + r*mδ.Rad()*cδ
+	x, y, z, mx, my, mz := spaceMotion(eqFrom, r, mr, mα, mδ)
 	t := epochTo - epochFrom
 	xp := x + t*mx
 	yp := y + t*my
@@ -343,3 +417,47 @@ func ProperMotion3D(eqFrom, eqTo *coord.Equatorial, epochFrom, epochTo, r, mr fl
 	eqTo.Dec = unit.Angle(math.Atan2(zp, math.Hypot(xp, yp)))
 	return eqTo
 }
+
+// spaceMotion returns rectangular position and velocity of an object
+// given its 3D equatorial coordinates and motion.
+func spaceMotion(eq *coord.Equatorial, r, mr float64, mα unit.HourAngle, mδ unit.Angle) (x, y, z, mx, my, mz float64) {
+	sα, cα := eq.RA.Sincos()
+	sδ, cδ := eq.Dec.Sincos()
+	x = r * cδ * cα
+	y = r * cδ * sα
+	z = r * sδ
+	mrr := mr / r
+	zmδ := z * mδ.Rad()
+	mx = x*mrr - zmδ*cα - y*mα.Rad()
+	my = y*mrr - zmδ*sα + x*mα.Rad()
+	mz = z*mrr + r*mδ.Rad()*cδ
+	return
+}
+
+// SpaceMotion is like ProperMotion3D but also computes the distance, radial
+// velocity, and proper motion at the new epoch.
+//
+// The space velocity of the object is taken as constant.  The proper motion
+// and radial velocity change over time as the direction to the object
+// changes; the effect, sometimes called foreshortening or perspective
+// acceleration, is significant for nearby stars of high proper motion.
+//
+// Units are as for ProperMotion3D.  Results rTo, mrTo, mαTo, mδTo are
+// distance, radial velocity, and proper motion at epochTo.
+func SpaceMotion(eqFrom, eqTo *coord.Equatorial, epochFrom, epochTo, r, mr float64, mα unit.HourAngle, mδ unit.Angle) (rTo, mrTo float64, mαTo unit.HourAngle, mδTo unit.Angle) {
+	x, y, z, mx, my, mz := spaceMotion(eqFrom, r, mr, mα, mδ)
+	t := epochTo - epochFrom
+	x += t * mx
+	y += t * my
+	z += t * mz
+	ρ := math.Hypot(x, y)
+	rTo = math.Hypot(ρ, z)
+	eqTo.RA = unit.RAFromRad(math.Atan2(y, x))
+	eqTo.Dec = unit.Angle(math.Atan2(z, ρ))
+	sα, cα := eqTo.RA.Sincos()
+	sδ, cδ := eqTo.Dec.Sincos()
+	mrTo = (x*mx + y*my + z*mz) / rTo
+	mαTo = unit.HourAngle((cα*my - sα*mx) / ρ)
+	mδTo = unit.Angle((cδ*mz - sδ*(cα*mx+sα*my)) / rTo)
+	return
+}
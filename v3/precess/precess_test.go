@@ -12,6 +12,7 @@ import (
 	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/elementequinox"
 	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/nutation"
 	"github.com/soniakeys/meeus/v3/precess"
 	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
@@ -138,6 +139,51 @@ func TestPrecessor_Precess(t *testing.T) {
 	}
 }
 
+func ExamplePrecessor_PrecessXYZ() {
+	// Example 21.b, p. 135, θ Persei, as a rectangular unit vector.
+	// Proper motion is applied first, as in the example.
+	epochTo := base.JDEToJulianYear(julian.CalendarGregorianToJD(2028, 11, 13.19))
+	dy := epochTo - 2000
+	α := unit.NewRA(2, 44, 11.986) + unit.RAFromSec(.03425*dy)
+	δ := unit.NewAngle(' ', 49, 13, 42.48) + unit.AngleFromSec(-.0895*dy)
+	sα, cα := α.Sincos()
+	sδ, cδ := δ.Sincos()
+	v := [][3]float64{{cδ * cα, cδ * sα, sδ}}
+	precess.NewPrecessor(2000, epochTo).PrecessXYZ(v)
+	α = unit.RAFromRad(math.Atan2(v[0][1], v[0][0]))
+	δ = unit.Angle(math.Asin(v[0][2]))
+	fmt.Printf("%0.3d\n", sexa.FmtRA(α))
+	fmt.Printf("%+0.2d\n", sexa.FmtAngle(δ))
+	// Output:
+	// 2ʰ46ᵐ11ˢ.331
+	// +49°20′54″.54
+}
+
+func TestPrecessXYZ(t *testing.T) {
+	// PrecessXYZ agrees with Precess.
+	p := precess.NewPrecessor(1950, 2100)
+	for _, eq := range []coord.Equatorial{
+		{RA: unit.RAFromDeg(0), Dec: unit.AngleFromDeg(0)},
+		{RA: unit.RAFromDeg(100), Dec: unit.AngleFromDeg(-60)},
+		{RA: unit.RAFromDeg(250), Dec: unit.AngleFromDeg(89)},
+	} {
+		var want coord.Equatorial
+		p.Precess(&eq, &want)
+		sα, cα := eq.RA.Sincos()
+		sδ, cδ := eq.Dec.Sincos()
+		v := [][3]float64{{cδ * cα, cδ * sα, sδ}}
+		p.PrecessXYZ(v)
+		sα, cα = want.RA.Sincos()
+		sδ, cδ = want.Dec.Sincos()
+		w := [3]float64{cδ * cα, cδ * sα, sδ}
+		for i := range w {
+			if math.Abs(v[0][i]-w[i]) > 1e-14 {
+				t.Fatal(eq, v[0], w)
+			}
+		}
+	}
+}
+
 func ExampleEclipticPosition() {
 	// Example 21.c, p. 137.
 	eclFrom := &coord.Ecliptic{
@@ -181,6 +227,45 @@ func ExampleProperMotion3D() {
 	// -10000.0  6ʰ52ᵐ25ˢ.72  -12°50′06″.7
 }
 
+func ExampleToEpoch() {
+	// Galactic coordinates of the Galactic Center converted to J2000.
+	eq := new(coord.EquatorialEpoch).GalToEq(&coord.Galactic{})
+	precess.ToEpoch(eq, eq, 2000)
+	fmt.Printf("J%.0f  %0.1d  %0.0d\n", eq.Epoch,
+		sexa.FmtRA(eq.RA), sexa.FmtAngle(eq.Dec))
+	_, err := new(coord.Galactic).EqEpochToGal(eq)
+	fmt.Println(err)
+	// Output:
+	// J2000  17ʰ45ᵐ37ˢ.2  -28°56′10″
+	// coordinates not referred to required epoch
+}
+
+func ExampleSpaceMotion() {
+	// Barnard's Star, Hipparcos data propagated over 10000 years.
+	eqFrom := &coord.Equatorial{
+		RA:  unit.NewRA(17, 57, 48.97),
+		Dec: unit.NewAngle(' ', 4, 41, 36.2),
+	}
+	mra := unit.HourAngle(unit.AngleFromSec(-.79858).Div(eqFrom.Dec.Cos()))
+	mdec := unit.AngleFromSec(10.32812)
+	r := 1 / .54831        // parsecs, from parallax
+	mr := -110.51 / 977792 // parsecs per year, from km/s
+	eqTo := &coord.Equatorial{}
+	for _, epoch := range []float64{2000, 4000, 6000, 8000, 12000} {
+		rTo, _, mαTo, mδTo := precess.SpaceMotion(eqFrom, eqTo,
+			2000, epoch, r, mr, mra, mdec)
+		fmt.Printf("%5.0f  %0.2d  %0.1d  %.3f pc  μα %+.3f″  μδ %+.3f″\n",
+			epoch, sexa.FmtRA(eqTo.RA), sexa.FmtAngle(eqTo.Dec), rTo,
+			unit.Angle(mαTo).Sec()*eqTo.Dec.Cos(), mδTo.Sec())
+	}
+	// Output:
+	//  2000  17ʰ57ᵐ48ˢ.97  4°41′36″.2  1.824 pc  μα -0.799″  μδ +10.328″
+	//  4000  17ʰ55ᵐ45ˢ.87  11°12′51″.3  1.608 pc  μα -1.043″  μδ +13.281″
+	//  6000  17ʰ52ᵐ58ˢ.57  19°36′03″.9  1.420 pc  μα -1.394″  μδ +17.036″
+	//  8000  17ʰ48ᵐ58ˢ.17  30°14′04″.9  1.271 pc  μα -1.898″  μδ +21.256″
+	// 12000  17ʰ31ᵐ40ˢ.95  57°18′25″.5  1.149 pc  μα -3.713″  μδ +25.838″
+}
+
 func ExampleEclipticPrecessor_ReduceElements() {
 	// Example 24.a, p. 160.
 	ele := &elementequinox.Elements{
@@ -200,3 +285,107 @@ func ExampleEclipticPrecessor_ReduceElements() {
 	// Ω = 48.6037
 	// ω = 151.4782
 }
+
+func ExampleReduceElementsStepped() {
+	// Elements of Example 24.a, reduced from equinox J2000 to the year -1000
+	// in one step and in steps of 100 years.
+	ele := &elementequinox.Elements{
+		Inc:  unit.AngleFromDeg(47.122),
+		Peri: unit.AngleFromDeg(151.4486),
+		Node: unit.AngleFromDeg(45.7481),
+	}
+	var one, stepped elementequinox.Elements
+	precess.NewEclipticPrecessor(2000, -1000).ReduceElements(ele, &one)
+	precess.ReduceElementsStepped(ele, &stepped, 2000, -1000)
+	fmt.Printf("i = %.4f  Δ %+.3f″\n",
+		stepped.Inc.Deg(), (one.Inc - stepped.Inc).Sec())
+	fmt.Printf("Ω = %.4f  Δ %+.3f″\n",
+		stepped.Node.Deg(), (one.Node.Mod1() - stepped.Node).Sec())
+	fmt.Printf("ω = %.4f  Δ %+.3f″\n",
+		stepped.Peri.Deg(), (one.Peri.Mod1() - stepped.Peri).Sec())
+	// Output:
+	// i = 46.8327  Δ +0.184″
+	// Ω = 4.3751  Δ +0.213″
+	// ω = 151.0700  Δ -0.321″
+}
+
+// reduceRigorous reduces elements e from equinox J2000 to epoch by rotating
+// the orbit to the equator, precessing it with the product of Precessor
+// matrices for steps of 100 years, and rotating it back to the ecliptic.
+func reduceRigorous(e *elementequinox.Elements, epoch float64) (i, Ω, ω float64) {
+	mul := func(a, b [3][3]float64) (c [3][3]float64) {
+		for r := range c {
+			for k := range c {
+				c[r][k] = a[r][0]*b[0][k] + a[r][1]*b[1][k] + a[r][2]*b[2][k]
+			}
+		}
+		return
+	}
+	// rotation from the ecliptic to the equator of epoch y; its transpose
+	// for the inverse
+	ecl := func(y float64, inverse bool) [3][3]float64 {
+		s, c := nutation.MeanObliquity(base.JulianYearToJDE(y)).Sincos()
+		if inverse {
+			s = -s
+		}
+		return [3][3]float64{{1, 0, 0}, {0, c, -s}, {0, s, c}}
+	}
+	m := ecl(2000, false)
+	for y := 2000.; y != epoch; {
+		y1 := math.Max(y-100, epoch)
+		if epoch > y {
+			y1 = math.Min(y+100, epoch)
+		}
+		m = mul(precess.NewPrecessor(y, y1).Matrix(), m)
+		y = y1
+	}
+	m = mul(ecl(epoch, true), m)
+	rot := func(v [3]float64) (r [3]float64) {
+		for k := range r {
+			r[k] = m[k][0]*v[0] + m[k][1]*v[1] + m[k][2]*v[2]
+		}
+		return
+	}
+	// pole of the orbit and direction of perihelion
+	si, ci := e.Inc.Sincos()
+	sΩ, cΩ := e.Node.Sincos()
+	sω, cω := e.Peri.Sincos()
+	p := rot([3]float64{si * sΩ, -si * cΩ, ci})
+	q := rot([3]float64{cΩ*cω - sΩ*sω*ci, sΩ*cω + cΩ*sω*ci, sω * si})
+	i = math.Acos(p[2])
+	Ω = math.Atan2(p[0], -p[1])
+	sΩ, cΩ = math.Sincos(Ω)
+	ω = math.Atan2(q[2]/math.Sin(i), q[0]*cΩ+q[1]*sΩ)
+	return
+}
+
+func TestReduceElementsStepped(t *testing.T) {
+	// Stepping is not closer to a rigorous reduction than a single
+	// reduction, as stated in the documentation.
+	ele := &elementequinox.Elements{
+		Inc:  unit.AngleFromDeg(47.122),
+		Peri: unit.AngleFromDeg(151.4486),
+		Node: unit.AngleFromDeg(45.7481),
+	}
+	Δ := func(a unit.Angle, b float64) float64 {
+		return math.Abs(math.Remainder(a.Rad()-b, 2*math.Pi))
+	}
+	for _, tc := range []struct{ epoch, max float64 }{
+		{1000, .2}, {0, 3.5}, {-1000, 18.5}, {-3000, 140}, {3000, .15},
+	} {
+		var one, stepped elementequinox.Elements
+		precess.NewEclipticPrecessor(2000, tc.epoch).ReduceElements(ele, &one)
+		precess.ReduceElementsStepped(ele, &stepped, 2000, tc.epoch)
+		i, Ω, ω := reduceRigorous(ele, tc.epoch)
+		if Δ(one.Node, Ω) > Δ(stepped.Node, Ω) ||
+			Δ(one.Peri, ω) > Δ(stepped.Peri, ω) {
+			t.Error(tc.epoch, "stepped closer than single reduction")
+		}
+		for _, d := range []float64{Δ(stepped.Inc, i), Δ(stepped.Node, Ω),
+			Δ(stepped.Peri, ω)} {
+			if d = unit.Angle(d).Sec(); d > tc.max {
+				t.Errorf("%v: %.3f″ from rigorous reduction", tc.epoch, d)
+			}
+		}
+	}
+}
@@ -10,6 +10,7 @@ package refraction
 import (
 	"math"
 
+	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/unit"
 )
 
@@ -84,3 +85,75 @@ func Saemundsson(h unit.Angle) unit.Angle {
 	hd := h.Deg()
 	return unit.AngleFromMin(1.02 / math.Tan((hd+10.3/(hd+5.11))*math.Pi/180))
 }
+
+// Factor returns a factor to adjust refraction for atmospheric conditions.
+//
+// Argument P is atmospheric pressure in millibars, T is temperature in
+// degrees Celsius.  Refraction values returned by other functions in this
+// package can be multiplied by this factor.  At the standard 1010 mb and 10°C
+// the factor is 1.
+func Factor(P, T float64) float64 {
+	// p. 107
+	return P / 1010 * 283 / (273 + T)
+}
+
+// lowLimit is the altitude below which refraction is not applied by
+// Observed and True.  The formulas are meaningless there.
+var lowLimit = unit.AngleFromDeg(-2)
+
+// Observed computes observed horizontal coordinates from equatorial
+// coordinates.
+//
+// Arguments α, δ, φ, ψ, st are as for coord.EqToHz.  P and T are atmospheric
+// pressure in millibars and temperature in degrees Celsius.
+//
+// Result A is azimuth as returned by coord.EqToHz.  Result h0 is apparent
+// altitude, the true altitude with refraction computed by Saemundsson added.
+// No refraction is added for true altitudes below -2°.
+func Observed(α unit.RA, δ, φ, ψ unit.Angle, st unit.Time, P, T float64) (A, h0 unit.Angle) {
+	A, h := coord.EqToHz(α, δ, φ, ψ, st)
+	if h < lowLimit {
+		return A, h
+	}
+	return A, h + Saemundsson(h).Mul(Factor(P, T))
+}
+
+// True returns true altitude from a measured apparent altitude.
+//
+// Argument h0 is the measured altitude, P and T are atmospheric pressure in
+// millibars and temperature in degrees Celsius.  Refraction is computed with
+// Bennett2.  No refraction is subtracted for altitudes below -2°.
+func True(h0 unit.Angle, P, T float64) unit.Angle {
+	if h0 < lowLimit {
+		return h0
+	}
+	return h0 - Bennett2(h0).Mul(Factor(P, T))
+}
+
+// AirMassPlane returns relative air mass for a plane-parallel atmosphere.
+//
+// Argument h is altitude, true or apparent.  The result is 1/sin h, adequate
+// above about 30° but increasingly too large toward the horizon, where it is
+// infinite.  Apparent altitude is the better choice near the horizon.
+func AirMassPlane(h unit.Angle) float64 {
+	return 1 / h.Sin()
+}
+
+// AirMassKastenYoung returns relative air mass by the formula of F. Kasten
+// and A. T. Young, "Revised optical air mass tables and approximation
+// formula", Applied Optics 28, 1989.
+//
+// Argument h0 is apparent altitude.  The result is about 38 at h0 = 0.
+func AirMassKastenYoung(h0 unit.Angle) float64 {
+	return 1 / (h0.Sin() + .50572*math.Pow(h0.Deg()+6.07995, -1.6364))
+}
+
+// AirMassPickering returns relative air mass by the formula of K. A.
+// Pickering, "The Southern Limits of the Ancient Star Catalog", DIO 12,
+// 2002.
+//
+// Argument h0 is apparent altitude.  The result is about 38.7 at h0 = 0.
+func AirMassPickering(h0 unit.Angle) float64 {
+	hd := h0.Deg()
+	return 1 / unit.AngleFromDeg(hd+244/(165+47*math.Pow(hd, 1.1))).Sin()
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/refraction"
 	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
@@ -44,3 +45,55 @@ func TestBennett(t *testing.T) {
 	}
 
 }
+
+func ExampleTrue() {
+	// Example 16.a, p. 107, with pressure and temperature of the
+	// standard atmosphere.
+	h0 := unit.AngleFromDeg(.5)
+	fmt.Printf("%.3f\n", refraction.Factor(1010, 10))
+	fmt.Printf("%.3m\n", sexa.FmtAngle(refraction.True(h0, 1010, 10)))
+	// Output:
+	// 1.000
+	// 1.304′
+}
+
+func TestObserved(t *testing.T) {
+	// round trip through Observed and True
+	α := unit.RAFromDeg(20)
+	δ := unit.AngleFromDeg(10)
+	φ := unit.AngleFromDeg(40)
+	for _, st := range []float64{1, 2, 3, 4, 5} {
+		A, h0 := refraction.Observed(α, δ, φ, 0, unit.TimeFromHour(st), 980, 25)
+		Ac, h := coord.EqToHz(α, δ, φ, 0, unit.TimeFromHour(st))
+		if A != Ac {
+			t.Fatal(A, Ac)
+		}
+		if h < 0 {
+			continue
+		}
+		if d := (refraction.True(h0, 980, 25) - h).Sec(); math.Abs(d) > 5 {
+			t.Errorf("st %g: h = %.6f, round trip error %.2f″", st, h.Deg(), d)
+		}
+	}
+}
+
+func ExampleAirMassPickering() {
+	// Compare air mass models at several apparent altitudes.
+	fmt.Println("   h0  plane  Kasten-Young  Pickering")
+	for _, hd := range []float64{90, 60, 30, 10, 5, 1, 0} {
+		h0 := unit.AngleFromDeg(hd)
+		fmt.Printf("%4.0f° %7.3f %10.3f %11.3f\n", hd,
+			refraction.AirMassPlane(h0),
+			refraction.AirMassKastenYoung(h0),
+			refraction.AirMassPickering(h0))
+	}
+	// Output:
+	// h0  plane  Kasten-Young  Pickering
+	//   90°   1.000      1.000       1.000
+	//   60°   1.155      1.154       1.154
+	//   30°   2.000      1.994       1.993
+	//   10°   5.759      5.586       5.581
+	//    5°  11.474     10.306      10.334
+	//    1°  57.299     26.311      26.644
+	//    0°    +Inf     37.920      38.749
+}
@@ -12,12 +12,20 @@
 // ApproxPlanet and Planet are also given here.  Similar methods for stars,
 // the Sun, Moon, Pluto, or asteroids might also be developed using other
 // packages from this library.
+//
+// Geographic coordinates of the observer are given as a globe.Coord, with
+// longitude positive west.  For longitude positive east, as from most modern
+// sources, construct the coordinates with globe.CoordFromEastLon.  Passing an
+// east longitude as Lon gives times for a meridian on the opposite side of
+// Greenwich, typically off by many hours.
 package rise
 
 import (
 	"errors"
 	"math"
 
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/deltat"
 	"github.com/soniakeys/meeus/v3/elliptic"
 	"github.com/soniakeys/meeus/v3/globe"
@@ -46,6 +54,17 @@ func Stdh0Lunar(π unit.Angle) unit.Angle {
 	return π.Mul(.7275) - meanRefraction
 }
 
+// Dip returns the dip of the sea horizon for an observer at height h meters.
+//
+// The value includes terrestrial refraction.  It is 1.76′ √h, the formula
+// of the dip table of the Nautical Almanac.  Result is 0 for h <= 0.
+func Dip(h float64) unit.Angle {
+	if h <= 0 {
+		return 0
+	}
+	return unit.AngleFromMin(1.76 * math.Sqrt(h))
+}
+
 // ErrorCircumpolar returned by Times when the object does not rise and
 // set on the day of interest.
 var ErrorCircumpolar = errors.New("Circumpolar")
@@ -56,7 +75,7 @@ var ErrorCircumpolar = errors.New("Circumpolar")
 // The function argurments do not actually include the day, but do include
 // values computed from the day.
 //
-//	p is geographic coordinates of observer.
+//	p is geographic coordinates of observer, longitude positive west.
 //	h0 is "standard altitude" of the body.
 //	Th0 is apparent sidereal time at 0h UT at Greenwich.
 //	α, δ are right ascension and declination of the body.
@@ -67,14 +86,12 @@ var ErrorCircumpolar = errors.New("Circumpolar")
 // α, δ must be values at 0h dynamical time for the day of interest.
 func ApproxTimes(p globe.Coord, h0 unit.Angle, Th0 unit.Time, α unit.RA, δ unit.Angle) (tRise, tTransit, tSet unit.Time, err error) {
 	// approximate local hour angle
-	sLat, cLat := p.Lat.Sincos()
-	sδ1, cδ1 := δ.Sincos()
-	cH0 := (h0.Sin() - sLat*sδ1) / (cLat * cδ1) // (15.1) p. 102
-	if cH0 < -1 || cH0 > 1 {
+	H, err := coord.HourAngleAtAltitude(p.Lat, δ, h0) // (15.1) p. 102
+	if err != nil {
 		err = ErrorCircumpolar
 		return
 	}
-	H0 := unit.TimeFromRad(math.Acos(cH0))
+	H0 := unit.TimeFromRad(H.Rad())
 
 	// approximate transit, rise, set times.
 	// (15.2) p. 102.
@@ -91,7 +108,7 @@ func ApproxTimes(p globe.Coord, h0 unit.Angle, Th0 unit.Time, α unit.RA, δ uni
 // The function argurments do not actually include the day, but do include
 // a number of values computed from the day.
 //
-//	p is geographic coordinates of observer.
+//	p is geographic coordinates of observer, longitude positive west.
 //	ΔT is delta T.
 //	h0 is "standard altitude" of the body.
 //	Th0 is apparent sidereal time at 0h UT at Greenwich.
@@ -134,6 +151,12 @@ func Times(p globe.Coord, ΔT unit.Time, h0 unit.Angle, Th0 unit.Time, α3 []uni
 		α := d3α.InterpolateX((tTransit + ΔT).Sec())
 		// local hour angle as Time
 		H := th0 - unit.TimeFromRad(p.Lon.Rad()+α)
+		// The correction Δm of p. 103 assumes H within ±180°.  Th0, α
+		// and the longitude are each reduced separately (and α may be
+		// unwrapped through 0h) so the difference can be off by a day,
+		// as for example at east longitudes.  Rise and set below use H
+		// only through sin and cos and need no reduction.
+		H = (H + 43200).Mod1() - 43200
 		tTransit -= H
 	}
 	// adjust tRise, tSet
@@ -158,11 +181,49 @@ func Times(p globe.Coord, ΔT unit.Time, h0 unit.Angle, Th0 unit.Time, α3 []uni
 	return
 }
 
+// TimesObserver computes UT rise, transit and set times as Times, for an
+// observer o.
+//
+// The standard altitude h0 is lowered by Dip(o.H), as for an observer above
+// a sea horizon, so that rising is earlier and setting later than for
+// Times at o.Coord.  For an observer whose horizon is not below the
+// observer, use Times with o.Coord.
+func TimesObserver(o globe.Observer, ΔT unit.Time, h0 unit.Angle, Th0 unit.Time, α3 []unit.RA, δ3 []unit.Angle) (tRise, tTransit, tSet unit.Time, err error) {
+	return Times(o.Coord, ΔT, h0-Dip(o.H), Th0, α3, δ3)
+}
+
+// MotionTimes computes UT rise, transit and set times for a celestial object
+// on a day of interest, from a single position and the daily motion of the
+// object.
+//
+// Accuracy is intermediate between ApproxTimes and Times.  Rather than three
+// positions, it takes one position and the rate of change of that position.
+// Positions through the day are extrapolated linearly from these.  For the
+// Sun and planets, where motion over a day is close to linear, results are
+// typically within seconds of Times.
+//
+// Arguments other than α, δ, dα, dδ are as for Times.
+//
+// α, δ must be values at 0h dynamical time for the day of interest.
+// dα, dδ are daily motions in right ascension and declination.
+//
+// Result units are seconds of day and are in the range [0,86400).
+func MotionTimes(p globe.Coord, ΔT unit.Time, h0 unit.Angle, Th0 unit.Time, α unit.RA, δ unit.Angle, dα unit.HourAngle, dδ unit.Angle) (tRise, tTransit, tSet unit.Time, err error) {
+	// three collinear positions, α not wrapped through 0h.
+	α3 := []unit.RA{
+		unit.RA(α.Rad() - dα.Rad()),
+		α,
+		unit.RA(α.Rad() + dα.Rad()),
+	}
+	δ3 := []unit.Angle{δ - dδ, δ, δ + dδ}
+	return Times(p, ΔT, h0, Th0, α3, δ3)
+}
+
 // ApproxPlanet computes approximate UT rise, transit and set times for
 // a planet on a day of interest.
 //
 //  yr, mon, day are the Gregorian date.
-//  pos is geographic coordinates of observer.
+//  pos is geographic coordinates of observer, longitude positive west.
 //  e must be a V87Planet object for Earth
 //  pl must be a V87Planet object for another planet.
 //
@@ -179,7 +240,7 @@ func ApproxPlanet(yr, mon, day int, pos globe.Coord, e, pl *pp.V87Planet) (tRise
 // interest.
 //
 //  yr, mon, day are the Gregorian date.
-//  pos is geographic coordinates of observer.
+//  pos is geographic coordinates of observer, longitude positive west.
 //  e must be a V87Planet object for Earth
 //  pl must be a V87Planet object for another planet.
 //
@@ -196,3 +257,72 @@ func Planet(yr, mon, day int, pos globe.Coord, e, pl *pp.V87Planet) (tRise, tTra
 	return Times(pos, deltat.Interp10A(jd), Stdh0Stellar,
 		sidereal.Apparent0UT(jd), α, δ)
 }
+
+// BodyEphemFunc returns the position of a body at time jde, for use with
+// Bodies.
+//
+// Results α, δ are apparent right ascension and declination.  Result h0 is
+// the standard altitude of the body, for example Stdh0Stellar for a planet
+// or Stdh0Lunar for the Moon.
+type BodyEphemFunc func(jde base.JDE) (α unit.RA, δ unit.Angle, h0 unit.Angle)
+
+// RiseSet holds the results of Times for one body of Bodies.
+type RiseSet struct {
+	Rise, Transit, Set unit.Time
+	Err                error
+}
+
+// Bodies computes UT rise, transit and set times for a number of bodies on
+// a day of interest.
+//
+//  yr, mon, day are the Gregorian date.
+//  pos is geographic coordinates of observer, longitude positive west.
+//  bodies are functions giving positions of the bodies.
+//
+// ΔT and sidereal time are computed once for all bodies.  Each function of
+// bodies is evaluated at 0h dynamical time of the day before, the day of,
+// and the day after the day of interest; h0 is taken from the evaluation
+// for the day of interest.  Right ascensions are unwrapped where they pass
+// through 0h so that a body such as the Sun near the March equinox can be
+// interpolated.
+//
+// Results are in the order of bodies, with units as for Times.
+func Bodies(yr, mon, day int, pos globe.Coord, bodies []BodyEphemFunc) []RiseSet {
+	return riseSets(yr, mon, day, pos, 0, bodies)
+}
+
+// BodiesObserver computes UT rise, transit and set times as Bodies, for an
+// observer o.
+//
+// The standard altitude h0 of each body is lowered by Dip(o.H), as for
+// TimesObserver.
+func BodiesObserver(yr, mon, day int, o globe.Observer, bodies []BodyEphemFunc) []RiseSet {
+	return riseSets(yr, mon, day, o.Coord, Dip(o.H), bodies)
+}
+
+func riseSets(yr, mon, day int, pos globe.Coord, dip unit.Angle, bodies []BodyEphemFunc) []RiseSet {
+	jd := julian.CalendarGregorianToJD(yr, mon, float64(day))
+	ΔT := deltat.Interp10A(jd)
+	Th0 := sidereal.Apparent0UT(jd)
+	α := make([]unit.RA, 3)
+	δ := make([]unit.Angle, 3)
+	r := make([]RiseSet, len(bodies))
+	jde := base.JDUT(jd).TT(ΔT)
+	for i, f := range bodies {
+		var h0 unit.Angle
+		α[0], δ[0], _ = f(jde - 1)
+		α[1], δ[1], h0 = f(jde)
+		α[2], δ[2], _ = f(jde + 1)
+		for _, j := range []int{0, 2} {
+			switch d := α[j] - α[1]; {
+			case d > math.Pi:
+				α[j] -= 2 * math.Pi
+			case d < -math.Pi:
+				α[j] += 2 * math.Pi
+			}
+		}
+		rs := &r[i]
+		rs.Rise, rs.Transit, rs.Set, rs.Err = Times(pos, ΔT, h0-dip, Th0, α, δ)
+	}
+	return r
+}
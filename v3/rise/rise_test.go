@@ -5,9 +5,16 @@ package rise_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
 
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/nutation"
 	"github.com/soniakeys/meeus/v3/rise"
+	"github.com/soniakeys/meeus/v3/solar"
 	"github.com/soniakeys/sexagesimal"
 	"github.com/soniakeys/unit"
 )
@@ -71,3 +78,193 @@ func ExampleTimes() {
 	// transit: +0.81980  19ʰ40ᵐ30ˢ
 	// seting:  +0.12130  02ʰ54ᵐ40ˢ
 }
+
+func ExampleTimes_eastLongitude() {
+	// Example 15.a, p. 103, with the coordinates of Boston given as
+	// longitude positive east, as from most modern sources.
+	p := globe.CoordFromEastLon(
+		unit.NewAngle(' ', 42, 20, 0),
+		unit.NewAngle('-', 71, 5, 0))
+	Th0 := unit.NewTime(' ', 11, 50, 58.1)
+	α3 := []unit.RA{
+		unit.NewRA(2, 42, 43.25),
+		unit.NewRA(2, 46, 55.51),
+		unit.NewRA(2, 51, 07.69),
+	}
+	δ3 := []unit.Angle{
+		unit.NewAngle(' ', 18, 02, 51.4),
+		unit.NewAngle(' ', 18, 26, 27.3),
+		unit.NewAngle(' ', 18, 49, 38.7),
+	}
+	h0 := unit.AngleFromDeg(-.5667)
+	ΔT := unit.Time(56)
+	tRise, tTransit, tSet, err := rise.Times(p, ΔT, h0, Th0, α3, δ3)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("rising:  %02s\n", sexa.FmtTime(tRise))
+	fmt.Printf("transit: %02s\n", sexa.FmtTime(tTransit))
+	fmt.Printf("seting:  %02s\n", sexa.FmtTime(tSet))
+	// Output:
+	// rising:   12ʰ25ᵐ26ˢ
+	// transit:  19ʰ40ᵐ30ˢ
+	// seting:   02ʰ54ᵐ40ˢ
+}
+
+func ExampleTimesObserver() {
+	// Example 15.a, p. 103, for observers at sea level and 100 m above
+	// the sea at Boston.
+	o := globe.Observer{Coord: globe.Coord{
+		Lon: unit.NewAngle(' ', 71, 5, 0),
+		Lat: unit.NewAngle(' ', 42, 20, 0),
+	}}
+	Th0 := unit.NewTime(' ', 11, 50, 58.1)
+	α3 := []unit.RA{
+		unit.NewRA(2, 42, 43.25),
+		unit.NewRA(2, 46, 55.51),
+		unit.NewRA(2, 51, 07.69),
+	}
+	δ3 := []unit.Angle{
+		unit.NewAngle(' ', 18, 02, 51.4),
+		unit.NewAngle(' ', 18, 26, 27.3),
+		unit.NewAngle(' ', 18, 49, 38.7),
+	}
+	for _, h := range []float64{0, 100} {
+		o.H = h
+		tRise, _, tSet, err := rise.TimesObserver(o, 56, rise.Stdh0Stellar,
+			Th0, α3, δ3)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%3.0f m  dip %4.1f′  rising %02s  setting %02s\n",
+			h, rise.Dip(h).Min(), sexa.FmtTime(tRise), sexa.FmtTime(tSet))
+	}
+	// Output:
+	// 0 m  dip  0.0′  rising  12ʰ25ᵐ26ˢ  setting  02ʰ54ᵐ40ˢ
+	// 100 m  dip 17.6′  rising  12ʰ23ᵐ39ˢ  setting  02ʰ56ᵐ26ˢ
+}
+
+func ExampleBodies() {
+	// Sun and Moon at Boston on 1988 March 20, the location and date of
+	// example 15.a, p. 103.
+	p := globe.Coord{
+		Lon: unit.NewAngle(' ', 71, 5, 0),
+		Lat: unit.NewAngle(' ', 42, 20, 0),
+	}
+	sun := func(jde base.JDE) (unit.RA, unit.Angle, unit.Angle) {
+		α, δ := solar.ApparentEquatorial(float64(jde))
+		return α, δ, rise.Stdh0Solar
+	}
+	moon := func(j base.JDE) (unit.RA, unit.Angle, unit.Angle) {
+		jde := float64(j)
+		λ, β, Δ := moonposition.Position(jde)
+		Δψ, Δε := nutation.Nutation(jde)
+		sε, cε := (nutation.MeanObliquity(jde) + Δε).Sincos()
+		α, δ := coord.EclToEq(λ+Δψ, β, sε, cε)
+		return α, δ, rise.Stdh0Lunar(moonposition.Parallax(Δ))
+	}
+	for i, r := range rise.Bodies(1988, 3, 20, p,
+		[]rise.BodyEphemFunc{sun, moon}) {
+		if r.Err != nil {
+			fmt.Println(r.Err)
+			continue
+		}
+		fmt.Printf("%-4s  rise %02m  transit %02m  set %02m\n",
+			[]string{"Sun", "Moon"}[i], sexa.FmtTime(r.Rise),
+			sexa.FmtTime(r.Transit), sexa.FmtTime(r.Set))
+	}
+	// Output:
+	// Sun   rise  10ʰ47ᵐ  transit  16ʰ52ᵐ  set  22ʰ57ᵐ
+	// Moon  rise  11ʰ51ᵐ  transit  19ʰ03ᵐ  set  01ʰ15ᵐ
+}
+
+func ExampleMotionTimes() {
+	// Example 15.a, p. 103, with the position of Venus on the day of
+	// interest and its daily motion rather than three positions.
+	p := globe.Coord{
+		Lon: unit.NewAngle(' ', 71, 5, 0),
+		Lat: unit.NewAngle(' ', 42, 20, 0),
+	}
+	Th0 := unit.NewTime(' ', 11, 50, 58.1)
+	α := unit.NewRA(2, 46, 55.51)
+	δ := unit.NewAngle(' ', 18, 26, 27.3)
+	dα := unit.NewHourAngle(' ', 0, 4, 12.2)
+	dδ := unit.NewAngle(' ', 0, 23, 23.6)
+	h0 := unit.AngleFromDeg(-.5667)
+	ΔT := unit.Time(56)
+	tRise, tTransit, tSet, err := rise.MotionTimes(p, ΔT, h0, Th0, α, δ, dα, dδ)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("rising:  %+.5f %02s\n", tRise/86400, sexa.FmtTime(tRise))
+	fmt.Printf("transit: %+.5f %02s\n", tTransit/86400, sexa.FmtTime(tTransit))
+	fmt.Printf("seting:  %+.5f %02s\n", tSet/86400, sexa.FmtTime(tSet))
+	// Output:
+	// rising:  +0.51765  12ʰ25ᵐ25ˢ
+	// transit: +0.81980  19ʰ40ᵐ30ˢ
+	// seting:  +0.12130  02ʰ54ᵐ40ˢ
+}
+
+func TestTimesTransitHourAngle(t *testing.T) {
+	// Positions near those of example 15.a, p. 103, over a range of right
+	// ascensions and longitudes.  The local hour angle of the transit
+	// correction wraps through 0h for some of these, where an unreduced
+	// hour angle would put transit a day away from ApproxTimes.
+	Th0 := unit.NewTime(' ', 11, 50, 58.1)
+	h0 := unit.AngleFromDeg(-.5667)
+	δ3 := []unit.Angle{
+		unit.AngleFromDeg(18),
+		unit.AngleFromDeg(18.4),
+		unit.AngleFromDeg(18.8),
+	}
+	for _, lon := range []float64{-150, -71, 0, 71, 150} {
+		p := globe.Coord{
+			Lat: unit.AngleFromDeg(42),
+			Lon: unit.AngleFromDeg(lon),
+		}
+		for _, h := range []float64{1, 6, 12, 18, 23} {
+			α3 := []unit.RA{
+				unit.RAFromHour(h - .07),
+				unit.RAFromHour(h),
+				unit.RAFromHour(h + .07),
+			}
+			_, tApprox, _, _ := rise.ApproxTimes(p, h0, Th0, α3[1], δ3[1])
+			_, tTransit, _, err := rise.Times(p, 56, h0, Th0, α3, δ3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs((tTransit - tApprox).Sec()) > 600 {
+				t.Errorf("lon %v α %vh: transit %.0f, approx %.0f",
+					lon, h, tTransit, tApprox)
+			}
+		}
+	}
+}
+
+func TestBodiesObserver(t *testing.T) {
+	// At sea level BodiesObserver is Bodies; above it the Sun rises
+	// earlier and sets later by the dip of the horizon.
+	o := globe.Observer{Coord: globe.Coord{
+		Lon: unit.NewAngle(' ', 71, 5, 0),
+		Lat: unit.NewAngle(' ', 42, 20, 0),
+	}}
+	sun := []rise.BodyEphemFunc{
+		func(jde base.JDE) (unit.RA, unit.Angle, unit.Angle) {
+			α, δ := solar.ApparentEquatorial(float64(jde))
+			return α, δ, rise.Stdh0Solar
+		},
+	}
+	b := rise.Bodies(1988, 3, 20, o.Coord, sun)[0]
+	if r := rise.BodiesObserver(1988, 3, 20, o, sun)[0]; r != b {
+		t.Fatal(r, b)
+	}
+	o.H = 100
+	r := rise.BodiesObserver(1988, 3, 20, o, sun)[0]
+	if r.Err != nil || !(r.Rise < b.Rise) || !(r.Set > b.Set) ||
+		math.Abs((r.Transit-b.Transit).Sec()) > 1e-6 {
+		t.Fatal(r, b)
+	}
+}
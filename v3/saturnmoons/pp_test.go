@@ -7,6 +7,7 @@ package saturnmoons_test
 
 import (
 	"fmt"
+	"testing"
 
 	pp "github.com/soniakeys/meeus/v3/planetposition"
 	"github.com/soniakeys/meeus/v3/saturnmoons"
@@ -39,3 +40,25 @@ func ExamplePositions() {
 	// 7:  -18.001   -5.328
 	// 8:  -48.760   +4.137
 }
+
+func TestPositionsDetail(t *testing.T) {
+	earth, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		t.Skip(err)
+	}
+	saturn, err := pp.LoadPlanet(pp.Saturn)
+	if err != nil {
+		t.Skip(err)
+	}
+	var want, got [8]saturnmoons.XY
+	var orb [8]saturnmoons.Orbital
+	saturnmoons.Positions(2451439.50074, earth, saturn, &want)
+	saturnmoons.PositionsDetail(2451439.50074, earth, saturn, &got, &orb)
+	if got != want {
+		t.Fatal(got, want)
+	}
+	// Titan at about 20 Saturn radii
+	if r := orb[5].R; r < 19 || r > 21 {
+		t.Fatal("Titan r =", r)
+	}
+}
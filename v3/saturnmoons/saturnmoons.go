@@ -26,6 +26,51 @@ const d = math.Pi / 180
 //
 // Result units are Saturn radii.
 func Positions(jde float64, earth, saturn *pp.V87Planet, pos *[8]XY) {
+	positions(jde, earth, saturn, pos)
+}
+
+// Orbital holds intermediate quantities of the theory of the moons of
+// Saturn for one moon.
+//
+// These are the quantities λ, r, γ, and Ω of chapter 46.  Inc and Node are
+// referred to the plane of the equator of Saturn.  Longitudes Lon and Node
+// are reckoned from the equinox of B1950, along the ecliptic to the node of
+// the equator of Saturn and then along that equator.
+type Orbital struct {
+	Lon  unit.Angle // λ, longitude of the moon in its orbit
+	R    float64    // r, radius vector in Saturn radii
+	Inc  unit.Angle // γ, inclination of the orbit
+	Node unit.Angle // Ω, longitude of the ascending node of the orbit
+}
+
+// Orbits returns intermediate quantities for the eight major moons of
+// Saturn at time jde.
+//
+// No correction is made for light time.  For quantities consistent with
+// the results of Positions, use PositionsDetail.
+func Orbits(jde float64) (orb [8]Orbital) {
+	s4 := newQs(jde).moons()
+	for j := range orb {
+		orb[j] = s4[j+1].orbital()
+	}
+	return
+}
+
+// PositionsDetail returns positions of the eight major moons of Saturn,
+// as Positions, along with the intermediate quantities used to compute them.
+//
+// Results are returned in arguments pos and orb, which must not be nil.
+// Quantities in orb are for the time of Positions corrected for light time.
+func PositionsDetail(jde float64, earth, saturn *pp.V87Planet, pos *[8]XY, orb *[8]Orbital) {
+	s4 := positions(jde, earth, saturn, pos)
+	for j := range orb {
+		orb[j] = s4[j+1].orbital()
+	}
+}
+
+// positions computes the results of Positions, returning intermediate
+// quantities indexed as s4 with element 0 unused.
+func positions(jde float64, earth, saturn *pp.V87Planet, pos *[8]XY) [9]r4 {
 	s, β, R := solar.TrueVSOP87(earth, jde)
 	ss, cs := s.Sincos()
 	sβ := β.Sin()
@@ -53,16 +98,7 @@ func Positions(jde float64, earth, saturn *pp.V87Planet, pos *[8]XY) {
 		base.JDEToJulianYear(jde), base.JDEToJulianYear(base.B1950), 0, 0)
 	λ0, β0 = ecl.Lon, ecl.Lat
 	q := newQs(JDE)
-	s4 := [9]r4{{}, // 0 unused
-		q.mimas(),
-		q.enceladus(),
-		q.tethys(),
-		q.dione(),
-		q.rhea(),
-		q.titan(),
-		q.hyperion(),
-		q.iapetus(),
-	}
+	s4 := q.moons()
 	var X, Y, Z [9]float64
 	for j := 1; j <= 8; j++ {
 		u := s4[j].λ - s4[j].Ω
@@ -105,7 +141,7 @@ func Positions(jde float64, earth, saturn *pp.V87Planet, pos *[8]XY) {
 		pos[j-1].X = X[j] * W
 		pos[j-1].Y = Y[j] * W
 	}
-	return
+	return s4
 }
 
 var k = [...]float64{0, 20947, 23715, 26382, 29876, 35313, 53800, 59222, 91820}
@@ -122,10 +158,10 @@ func newQs(JDE float64) *qs {
 	var q qs
 	q.t1 = JDE - 2411093
 	q.t2 = q.t1 / 365.25
-	q.t3 = (JDE-2433282.423)/365.25 + 1950
+	q.t3 = (JDE-base.B1950)/365.25 + 1950
 	q.t4 = JDE - 2411368
 	q.t5 = q.t4 / 365.25
-	q.t6 = JDE - 2415020
+	q.t6 = JDE - base.J1900
 	q.t7 = q.t6 / 36525
 	q.t8 = q.t6 / 365.25
 	q.t9 = (JDE - 2442000.5) / 365.25
@@ -156,6 +192,29 @@ func newQs(JDE float64) *qs {
 
 type r4 struct{ λ, r, γ, Ω float64 }
 
+func (r r4) orbital() Orbital {
+	return Orbital{
+		Lon:  unit.Angle(r.λ).Mod1(),
+		R:    r.r,
+		Inc:  unit.Angle(r.γ),
+		Node: unit.Angle(r.Ω).Mod1(),
+	}
+}
+
+// moons computes intermediate quantities for all moons, indexed from 1.
+func (q *qs) moons() [9]r4 {
+	return [9]r4{{}, // 0 unused
+		q.mimas(),
+		q.enceladus(),
+		q.tethys(),
+		q.dione(),
+		q.rhea(),
+		q.titan(),
+		q.hyperion(),
+		q.iapetus(),
+	}
+}
+
 func (q *qs) mimas() (r r4) {
 	L := 127.64*d + 381.994497*d*q.t1 -
 		43.57*d*q.sW0 - .72*d*q.s3W0 - .02144*d*q.s5W0
@@ -0,0 +1,28 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package saturnmoons_test
+
+import (
+	"fmt"
+
+	"github.com/soniakeys/meeus/v3/saturnmoons"
+)
+
+func ExampleOrbits() {
+	// Time of example 46.a, p. 334, without correction for light time.
+	orb := saturnmoons.Orbits(2451439.50074)
+	for i, o := range orb {
+		fmt.Printf("%d:  λ %7.3f°  r %7.3f  γ %6.3f°  Ω %7.3f°\n",
+			i+1, o.Lon.Deg(), o.R, o.Inc.Deg(), o.Node.Deg())
+	}
+	// Output:
+	// 1:  λ 338.210°  r   3.128  γ  1.563°  Ω  47.662°
+	// 2:  λ 313.542°  r   3.929  γ  0.026°  Ω 123.193°
+	// 3:  λ 357.390°  r   4.881  γ  1.098°  Ω  51.052°
+	// 4:  λ 109.130°  r   6.257  γ  0.014°  Ω 128.294°
+	// 5:  λ  53.928°  r   8.723  γ  0.336°  Ω 118.257°
+	// 6:  λ 271.673°  r  19.916  γ  0.370°  Ω   8.447°
+	// 7:  λ  92.782°  r  24.159  γ  1.046°  Ω  21.599°
+	// 8:  λ 103.358°  r  58.946  γ 15.506°  Ω  22.375°
+}
@@ -7,6 +7,7 @@ package saturnring
 import (
 	"math"
 
+	"github.com/soniakeys/meeus/v3/angle"
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/coord"
 	"github.com/soniakeys/meeus/v3/nutation"
@@ -27,7 +28,8 @@ const (
 //	B  Saturnicentric latitude of the Earth referred to the plane of the ring.
 //	Bʹ  Saturnicentric latitude of the Sun referred to the plane of the ring.
 //	ΔU  Difference between Saturnicentric longitudes of the Sun and the Earth.
-//	P  Geometric position angle of the northern semiminor axis of the ring.
+//	P  Geometric position angle of the northern semiminor axis of the ring,
+//	   in the range [0, 2π) as returned by angle.PositionAngle.
 //	aEdge  Major axis of the out edge of the outer ring.
 //	bEdge  Minor axis of the out edge of the outer ring.
 func Ring(jde float64, earth, saturn *pp.V87Planet) (B, Bʹ, ΔU, P, aEdge, bEdge unit.Angle) {
@@ -129,10 +131,9 @@ func cl(jde float64, earth, saturn *pp.V87Planet) (f1 func() (ΔU, B unit.Angle)
 		α0, δ0 := coord.EclToEq(λ0, β0, sε, cε)
 		α, δ := coord.EclToEq(λ, β, sε, cε)
 		// Step 15.
-		sδ0, cδ0 := δ0.Sincos()
-		sδ, cδ := δ.Sincos()
-		sα0α, cα0α := (α0 - α).Sincos()
-		P = unit.Angle(math.Atan2(cδ0*sα0α, sδ0*cδ-cδ0*sδ*cα0α)) // return value
+		P = angle.PositionAngle( // return value
+			&coord.Equatorial{RA: α, Dec: δ},
+			&coord.Equatorial{RA: α0, Dec: δ0})
 		return
 	}
 	return
@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/globe"
 	"github.com/soniakeys/meeus/v3/parallax"
 	"github.com/soniakeys/unit"
 )
@@ -69,6 +70,16 @@ func MoonTopocentric(Δ float64, δ unit.Angle, H unit.HourAngle, ρsφʹ, ρcφ
 	return k / q * sπ
 }
 
+// MoonTopocentricObserver returns observed topocentric semidiameter of the
+// Moon for an observer o.
+//
+// It is the same as MoonTopocentric except that the parallax constants are
+// taken from o.
+func MoonTopocentricObserver(Δ float64, δ unit.Angle, H unit.HourAngle, o globe.Observer) float64 {
+	ρsφʹ, ρcφʹ := o.ParallaxConstants()
+	return MoonTopocentric(Δ, δ, H, ρsφʹ, ρcφʹ)
+}
+
 // MoonTopocentric2 returns observed topocentric semidiameter of the Moon
 // by a less rigorous method.
 //
@@ -95,3 +106,13 @@ func AsteroidDiameter(H, A float64) float64 {
 func Asteroid(d, Δ float64) unit.Angle {
 	return unit.AngleFromSec(.0013788).Mul(d / Δ)
 }
+
+// AsteroidH returns the apparent size of an asteroid, as computed by
+// Asteroid, with diameter estimated from absolute magnitude and albedo.
+//
+// Arguments H and A are as for AsteroidDiameter, Δ is distance in AU.
+// The result is only as good as the assumed albedo.  Albedos of minor
+// planets range from about .03 to .5.
+func AsteroidH(H, A, Δ float64) unit.Angle {
+	return Asteroid(AsteroidDiameter(H, A), Δ)
+}
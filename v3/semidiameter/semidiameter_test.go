@@ -0,0 +1,50 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package semidiameter_test
+
+import (
+	"fmt"
+
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/semidiameter"
+	"github.com/soniakeys/unit"
+)
+
+func ExampleAsteroidDiameter() {
+	// Ceres, H = 3.34, assumed albedo .09.
+	fmt.Printf("%.0f km\n", semidiameter.AsteroidDiameter(3.34, .09))
+	// Output:
+	// 944 km
+}
+
+func ExampleAsteroidH() {
+	// Ceres at opposition, distance 1.6 AU.
+	s := semidiameter.AsteroidH(3.34, .09, 1.6)
+	fmt.Printf("%.3f″\n", s.Sec())
+	// Output:
+	// 0.813″
+}
+
+func ExampleMoonTopocentricObserver() {
+	// The Moon at the distance and declination of example 47.a, p. 342,
+	// seen on the meridian and 5h west of it from Palomar, the location
+	// of example 11.a, p. 82.
+	o := globe.Observer{
+		Coord: globe.Coord{
+			Lat: unit.NewAngle(' ', 33, 21, 22),
+			Lon: unit.NewAngle(' ', 116, 51, 47),
+		},
+		H: 1706,
+	}
+	Δ := 368409.7 / base.AU
+	δ := unit.AngleFromDeg(13.768368)
+	for _, H := range []unit.HourAngle{0, unit.HourAngleFromHour(5)} {
+		s := semidiameter.MoonTopocentricObserver(Δ, δ, H, o)
+		fmt.Printf("%.2f″\n", unit.Angle(s).Sec())
+	}
+	// Output:
+	// 989.08″
+	// 978.60″
+}
@@ -68,6 +68,21 @@ func Apparent(jd float64) unit.Time {
 	return (s + n.Time()).Mod1()
 }
 
+// ApparentNutation returns apparent sidereal time at Greenwich for the
+// given JD, using precomputed nutation.
+//
+// Arguments Δψ and ε are nutation in longitude and true obliquity, as
+// computed for example with nutation.Nutation and nutation.MeanObliquity.
+// ApparentNutation allows programs that already have these values to avoid
+// the cost of evaluating the nutation series again.
+//
+// The result is in the range [0,86400).
+func ApparentNutation(jd float64, Δψ, ε unit.Angle) unit.Time {
+	s := mean(jd)
+	n := unit.HourAngle(Δψ.Rad() * ε.Cos())
+	return (s + n.Time()).Mod1()
+}
+
 // Apparent0UT returns apparent sidereal time at Greenwich at 0h UT
 // on the given JD.
 //
@@ -80,3 +95,18 @@ func Apparent0UT(jd float64) unit.Time {
 	n := nutation.NutationInRA(j0) // HourAngle
 	return (s + n.Time()).Mod1()
 }
+
+// LocalHourAngle returns the local hour angle of a body.
+//
+// Argument st is sidereal time at Greenwich, ψ is the longitude of the
+// observer, positive west, and α is the right ascension of the body.
+// As for coord.EqToHz, st must be apparent if α is apparent.
+//
+// The result is in the range [-π, π), negative east of the meridian.
+func LocalHourAngle(st unit.Time, ψ unit.Angle, α unit.RA) unit.HourAngle {
+	H := unit.PMod(st.Rad()-ψ.Rad()-α.Rad(), 2*math.Pi) // p. 92
+	if H >= math.Pi {
+		H -= 2 * math.Pi
+	}
+	return unit.HourAngle(H)
+}
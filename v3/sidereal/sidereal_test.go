@@ -10,6 +10,7 @@ import (
 	"github.com/soniakeys/meeus/v3/julian"
 	"github.com/soniakeys/meeus/v3/sidereal"
 	"github.com/soniakeys/sexagesimal"
+	"github.com/soniakeys/unit"
 )
 
 func ExampleMean_a() {
@@ -31,3 +32,23 @@ func ExampleMean_b() {
 	// Output:
 	// 8ʰ34ᵐ57ˢ.0896
 }
+
+func ExampleApparentNutation() {
+	// Example 12.a, p. 88, with nutation from example 22.a, p. 148.
+	jd := 2446895.5
+	Δψ := unit.AngleFromSec(-3.788)
+	ε := unit.NewAngle(' ', 23, 26, 36.85)
+	fmt.Printf("%.4d\n", sexa.FmtTime(sidereal.ApparentNutation(jd, Δψ, ε)))
+	// Output:
+	// 13ʰ10ᵐ46ˢ.1351
+}
+
+func ExampleLocalHourAngle() {
+	// Example 13.b, p. 95.
+	jd := julian.TimeToJD(time.Date(1987, 4, 10, 19, 21, 0, 0, time.UTC))
+	H := sidereal.LocalHourAngle(sidereal.Apparent(jd),
+		unit.NewAngle(' ', 77, 3, 56), unit.NewRA(23, 9, 16.641))
+	fmt.Printf("H = %.4f°\n", unit.Angle(H).Deg())
+	// Output:
+	// H = 64.3520°
+}
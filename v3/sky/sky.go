@@ -0,0 +1,291 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+// Sky: Positions and appearance of the Sun, Moon, and planets as seen by
+// an observer.
+//
+// This package does not correspond to a chapter of the book.  It combines
+// functions of several chapter packages into the computation that much
+// planetarium-style software performs at each instant:  apparent place,
+// parallax, horizontal coordinates with refraction, magnitude, phase, and
+// angular size.  It also estimates the brightness of the sky due to
+// moonlight and twilight, for observation planning.
+package sky
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/soniakeys/meeus/v3/angle"
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/coord"
+	"github.com/soniakeys/meeus/v3/elliptic"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/illum"
+	"github.com/soniakeys/meeus/v3/moonillum"
+	"github.com/soniakeys/meeus/v3/moonposition"
+	"github.com/soniakeys/meeus/v3/nutation"
+	"github.com/soniakeys/meeus/v3/parallax"
+	"github.com/soniakeys/meeus/v3/planetary"
+	pp "github.com/soniakeys/meeus/v3/planetposition"
+	"github.com/soniakeys/meeus/v3/refraction"
+	"github.com/soniakeys/meeus/v3/semidiameter"
+	"github.com/soniakeys/meeus/v3/sidereal"
+	"github.com/soniakeys/meeus/v3/solar"
+	"github.com/soniakeys/unit"
+)
+
+// Body identifies a body of a snapshot.
+type Body int
+
+// Bodies, in the order returned by Snapshot.
+const (
+	Sun Body = iota
+	Moon
+	Mercury
+	Venus
+	Mars
+	Jupiter
+	Saturn
+	Uranus
+	Neptune
+)
+
+var bodyName = [...]string{"Sun", "Moon", "Mercury", "Venus", "Mars",
+	"Jupiter", "Saturn", "Uranus", "Neptune"}
+
+func (b Body) String() string {
+	return bodyName[b]
+}
+
+// Object holds the position and appearance of a body.
+type Object struct {
+	Body Body
+	RA   unit.RA    // apparent geocentric right ascension
+	Dec  unit.Angle // apparent geocentric declination
+	Az   unit.Angle // azimuth, measured westward from the South
+	Alt  unit.Angle // topocentric altitude, including refraction
+	Mag  float64    // visual magnitude
+	I    unit.Angle // phase angle
+	K    float64    // illuminated fraction of the disk
+	SD   unit.Angle // geocentric semidiameter
+}
+
+// Observer holds the location and atmospheric conditions of an observer.
+type Observer struct {
+	globe.Observer
+	P float64 // atmospheric pressure in millibars
+	T float64 // temperature in degrees Celsius
+}
+
+// MarshalJSON encodes o as a JSON object with the members of
+// globe.Observer.MarshalJSON and members "p" and "t".
+//
+// The method overrides that of the embedded globe.Observer so that the
+// atmospheric conditions are not lost.  Angles are in degrees.
+func (o Observer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.JSONValue(base.Degree))
+}
+
+// JSONValue returns a value for encoding o with package encoding/json as
+// the object of MarshalJSON, with angles in unit u.
+func (o Observer) JSONValue(u base.AngleUnit) interface{} {
+	return struct {
+		Lat   interface{}     `json:"lat"`
+		Lon   interface{}     `json:"lon"`
+		H     float64         `json:"h"`
+		Datum globe.Ellipsoid `json:"datum"`
+		P     float64         `json:"p"`
+		T     float64         `json:"t"`
+	}{u.JSONAngle(o.Lat), u.JSONAngle(o.Lon), o.H, o.Datum, o.P, o.T}
+}
+
+// planets lists the planet constants of package planetposition in the order
+// of the Body constants, starting with Mercury.
+var planets = [...]int{pp.Mercury, pp.Venus, pp.Mars, pp.Jupiter,
+	pp.Saturn, pp.Uranus, pp.Neptune}
+
+// Snapshot computes positions and appearance of the Sun, Moon and planets
+// at time j for observer o.
+//
+// Argument ΔT is used to compute sidereal time.  Argument v holds V87Planet
+// objects indexed by the planet constants of package planetposition, as
+// returned by planetposition.LoadPlanet.  If v is too short to hold Earth or
+// v[pp.Earth] is nil, only the Sun and Moon are computed.  Otherwise planets
+// with non-nil elements of v are computed.
+//
+// The Sun and Moon are computed with the methods of packages solar and
+// moonposition, planets with elliptic.PositionElongation.  The magnitude of
+// the Moon is from illum.Moon.  Magnitudes, semidiameters and distances of
+// the planets are from planetary.Circumstances.  Parallax, and so the
+// topocentric positions and the semidiameter of the Moon, use the equatorial
+// radius of o.Ellipsoid(); see globe.EarthEllipsoid.
+//
+// Objects are returned in the order of the Body constants.
+func Snapshot(j base.JDE, ΔT unit.Time, o Observer, v []*pp.V87Planet) []Object {
+	jde := float64(j)
+	jd := float64(j.UT(ΔT))
+	st := sidereal.Apparent(jd)
+	// horizontal coordinates for a body at distance Δ km
+	hz := func(ob *Object, Δ float64) {
+		α, δ := parallax.TopocentricObserverKm(ob.RA, ob.Dec, Δ, o.Observer, jd)
+		ob.Az, ob.Alt = refraction.Observed(α, δ, o.Lat, o.Lon, st, o.P, o.T)
+		ob.Az = ob.Az.Mod1()
+	}
+	// Sun
+	T := j.Century()
+	R := solar.Radius(T)
+	s := Object{Body: Sun, Mag: -26.74, K: 1}
+	s.RA, s.Dec = solar.ApparentEquatorial(jde)
+	s.SD = semidiameter.Semidiameter(semidiameter.Sun, R)
+	hz(&s, R*base.AU)
+	r := []Object{s}
+	// Moon
+	λ, β, Δ := moonposition.Position(jde)
+	Δψ, Δε := nutation.Nutation(jde)
+	sε, cε := (nutation.MeanObliquity(jde) + Δε).Sincos()
+	m := Object{Body: Moon}
+	m.RA, m.Dec = coord.EclToEq(λ+Δψ, β, sε, cε)
+	m.I = moonillum.PhaseAngleEq(m.RA, m.Dec, Δ, s.RA, s.Dec, R*base.AU)
+	m.K = base.Illuminated(m.I)
+	m.Mag = illum.Moon(R, Δ, m.I)
+	// k of semidiameter.MoonTopocentric
+	m.SD = unit.Angle(math.Asin(.272481 * o.Ellipsoid().Parallax(Δ).Sin()))
+	hz(&m, Δ)
+	r = append(r, m)
+	// planets
+	if len(v) <= pp.Earth || v[pp.Earth] == nil {
+		return r
+	}
+	earth := v[pp.Earth]
+	for n, pn := range planets {
+		if pn >= len(v) || v[pn] == nil {
+			continue
+		}
+		p := v[pn]
+		ob := Object{Body: Mercury + Body(n)}
+		ob.RA, ob.Dec, _, ob.I = elliptic.PositionElongation(p, earth, jde)
+		ob.K = base.Illuminated(ob.I)
+		// planetary constants are numbered as those of planetposition
+		_, Δ, mag, diameter, err := planetary.Circumstances(pn, jde, earth, p)
+		if err != nil {
+			continue
+		}
+		ob.Mag = mag
+		ob.SD = diameter / 2
+		hz(&ob, Δ*base.AU)
+		r = append(r, ob)
+	}
+	return r
+}
+
+// DarkZenith is the brightness of the moonless night sky at the zenith, in
+// nanolamberts.  It corresponds to V = 21.587 magnitudes per square arc
+// second, the value adopted by Krisciunas and Schaefer for Mauna Kea.
+const DarkZenith = 79.
+
+// NLToMag converts a surface brightness in nanolamberts to V magnitudes per
+// square arc second.
+func NLToMag(b float64) float64 {
+	// Krisciunas and Schaefer (1991), PASP 103, 1033, eq. 1
+	return (20.7233 - math.Log(b/34.08)) / .92104
+}
+
+// MagToNL converts a surface brightness in V magnitudes per square arc
+// second to nanolamberts.
+//
+// It is the inverse of NLToMag.
+func MagToNL(v float64) float64 {
+	return 34.08 * math.Exp(20.7233-.92104*v)
+}
+
+// airMass is the air mass of the model of Krisciunas and Schaefer for zenith
+// distance z.
+func airMass(z unit.Angle) float64 {
+	s := z.Sin()
+	return 1 / math.Sqrt(1-.96*s*s)
+}
+
+// Moonlight returns the brightness of the sky due to scattered moonlight,
+// in nanolamberts.
+//
+//	i is the phase angle of the Moon.
+//	ρ is the angular distance of the sky position from the Moon.
+//	zMoon is the zenith distance of the Moon.
+//	z is the zenith distance of the sky position.
+//	k is the extinction coefficient in V, magnitudes per air mass.
+//
+// The model is that of Krisciunas and Schaefer (1991), good to 8 to 23
+// percent in their tests at Mauna Kea.  Result is 0 if the Moon is below
+// the horizon.
+func Moonlight(i, ρ, zMoon, z unit.Angle, k float64) float64 {
+	if zMoon > math.Pi/2 {
+		return 0
+	}
+	id := math.Abs(i.Deg())
+	// illuminance of the Moon outside the atmosphere, eq. 20
+	I := math.Pow(10, -.4*(3.84+.026*id+4e-9*id*id*id*id))
+	// scattering function, eq. 21
+	cρ := ρ.Cos()
+	f := math.Pow(10, 5.36)*(1.06+cρ*cρ) + math.Pow(10, 6.15-ρ.Deg()/40)
+	// eq. 15
+	return f * I * math.Pow(10, -.4*k*airMass(zMoon)) *
+		(1 - math.Pow(10, -.4*k*airMass(z)))
+}
+
+// DarkSky returns the brightness of the moonless night sky at zenith
+// distance z, in nanolamberts.
+//
+// Argument b0 is the brightness at the zenith, for example DarkZenith,
+// and k is the extinction coefficient in V.
+func DarkSky(z unit.Angle, b0, k float64) float64 {
+	// Krisciunas and Schaefer (1991), eq. 2
+	X := airMass(z)
+	return b0 * math.Pow(10, -.4*k*(X-1)) * X
+}
+
+// Twilight returns the brightness of the twilight sky at zenith distance z,
+// in nanolamberts.
+//
+// Argument sunAlt is the altitude of the Sun, k is the extinction
+// coefficient in V.
+//
+// The model is a crude empirical one:  the zenith twilight sky fades
+// linearly in magnitude, from about 3.9 magnitudes per square arc second at
+// sunset by 1.25 magnitudes per degree of depression of the Sun, and away
+// from the zenith it brightens with air mass as the dark sky does.  It
+// ignores the brightening of the sky toward the Sun and should be taken
+// as good to about a magnitude.  For the Sun above the horizon, the value
+// at sunset is returned.
+func Twilight(sunAlt, z unit.Angle, k float64) float64 {
+	d := -sunAlt.Deg()
+	if d < 0 {
+		d = 0
+	}
+	return DarkSky(z, MagToNL(3.9+1.25*d), k)
+}
+
+// Brightness estimates the V surface brightness of the sky, in magnitudes
+// per square arc second, at a sky position seen by an observer.
+//
+//	j, ΔT, o are as for Snapshot.
+//	α, δ are apparent equatorial coordinates of the sky position.
+//	k is the extinction coefficient in V, magnitudes per air mass.
+//
+// Result is the sum of DarkSky with DarkZenith, Moonlight, and Twilight,
+// with positions of the Sun and Moon computed by Snapshot.  It is an
+// estimate for a clear sky away from artificial light, for a sky position
+// above the horizon.
+func Brightness(j base.JDE, ΔT unit.Time, o Observer, α unit.RA, δ unit.Angle, k float64) float64 {
+	sm := Snapshot(j, ΔT, o, nil)
+	s, m := &sm[Sun], &sm[Moon]
+	st := sidereal.Apparent(float64(j.UT(ΔT)))
+	A, h := coord.EqToHz(α, δ, o.Lat, o.Lon, st)
+	z := math.Pi/2 - h
+	// azimuth and altitude serve as longitude and latitude
+	ρ := angle.SepPauwels(A, h, m.Az, m.Alt)
+	b := DarkSky(z, DarkZenith, k) +
+		Moonlight(m.I, ρ, math.Pi/2-m.Alt, z, k) +
+		Twilight(s.Alt, z, k)
+	return NLToMag(b)
+}
@@ -0,0 +1,120 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package sky_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/soniakeys/meeus/v3/base"
+	"github.com/soniakeys/meeus/v3/globe"
+	"github.com/soniakeys/meeus/v3/julian"
+	pp "github.com/soniakeys/meeus/v3/planetposition"
+	"github.com/soniakeys/meeus/v3/sidereal"
+	"github.com/soniakeys/meeus/v3/sky"
+	"github.com/soniakeys/unit"
+)
+
+func ExampleSnapshot() {
+	// Sun and Moon at the time of example 47.a, p. 342, for an observer
+	// at Palomar, the location of example 40.a, p. 280.
+	jde := julian.CalendarGregorianToJD(1992, 4, 12)
+	o := sky.Observer{
+		Observer: globe.Observer{
+			Coord: globe.Coord{
+				Lat: unit.NewAngle(' ', 33, 21, 22),
+				Lon: unit.NewAngle(' ', 116, 51, 47),
+			},
+			H: 1706,
+		},
+		P: 1010,
+		T: 10,
+	}
+	for _, ob := range sky.Snapshot(base.JDE(jde), unit.Time(59), o, nil) {
+		fmt.Printf("%-4v  Az %7.3f  Alt %7.3f  Mag %6.2f  k %.3f  SD %.2f′\n",
+			ob.Body, ob.Az.Deg(), ob.Alt.Deg(), ob.Mag, ob.K, ob.SD.Min())
+	}
+	// Output:
+	// Sun   Az  82.017  Alt  27.552  Mag -26.74  k 1.000  SD 15.95′
+	// Moon  Az 280.068  Alt  38.857  Mag -10.93  k 0.679  SD 16.22′
+}
+
+func ExampleMoonlight() {
+	// Sky brightness 45° from the Moon, with the Moon and sky position both
+	// 60° high, for a range of phases.  Extinction is that of Mauna Kea.
+	z := unit.AngleFromDeg(30)
+	ρ := unit.AngleFromDeg(45)
+	dark := sky.DarkSky(z, sky.DarkZenith, .172)
+	for _, i := range []float64{0, 45, 90, 135} {
+		m := sky.Moonlight(unit.AngleFromDeg(i), ρ, z, z, .172)
+		fmt.Printf("i %3.0f°  V %.2f\n", i, sky.NLToMag(m+dark))
+	}
+	// Output:
+	// i   0°  V 18.10
+	// i  45°  V 19.20
+	// i  90°  V 20.30
+	// i 135°  V 21.23
+}
+
+func ExampleTwilight() {
+	// Zenith sky brightness through evening twilight.
+	dark := sky.DarkSky(0, sky.DarkZenith, .172)
+	for _, h := range []float64{0, -6, -12, -15, -18} {
+		t := sky.Twilight(unit.AngleFromDeg(h), 0, .172)
+		fmt.Printf("Sun %3.0f°  V %5.2f\n", h, sky.NLToMag(t+dark))
+	}
+	// Output:
+	// Sun   0°  V  3.90
+	// Sun  -6°  V 11.40
+	// Sun -12°  V 18.81
+	// Sun -15°  V 21.24
+	// Sun -18°  V 21.57
+}
+
+func ExampleBrightness() {
+	// Zenith sky at Palomar at the time of ExampleSnapshot, in daylight,
+	// and that evening in moonlight.
+	o := sky.Observer{
+		Observer: globe.Observer{
+			Coord: globe.Coord{
+				Lat: unit.NewAngle(' ', 33, 21, 22),
+				Lon: unit.NewAngle(' ', 116, 51, 47),
+			},
+			H: 1706,
+		},
+		P: 1010,
+		T: 10,
+	}
+	for _, d := range []float64{12, 12.2} {
+		jde := base.JDE(julian.CalendarGregorianToJD(1992, 4, d))
+		ΔT := unit.Time(59)
+		st := sidereal.Apparent(float64(jde.UT(ΔT)))
+		// zenith: right ascension is local sidereal time
+		α := (st - unit.TimeFromRad(o.Lon.Rad())).RA()
+		fmt.Printf("%.2f\n", sky.Brightness(jde, ΔT, o, α, o.Lat, .2))
+	}
+	// Output:
+	// 3.90
+	// 19.33
+}
+
+func TestNLToMag(t *testing.T) {
+	if v := sky.NLToMag(sky.DarkZenith); math.Abs(v-21.587) > .002 {
+		t.Fatal(v)
+	}
+	if b := sky.MagToNL(sky.NLToMag(1234)); math.Abs(b-1234) > 1e-9 {
+		t.Fatal(b)
+	}
+}
+
+func TestSnapshotShortV(t *testing.T) {
+	// a v too short to hold Earth gives only the Sun and Moon
+	jde := base.JDE(julian.CalendarGregorianToJD(1992, 4, 12))
+	for _, v := range [][]*pp.V87Planet{{}, make([]*pp.V87Planet, pp.Earth)} {
+		if r := sky.Snapshot(jde, 59, sky.Observer{}, v); len(r) != 2 {
+			t.Fatal(len(v), len(r))
+		}
+	}
+}
@@ -31,7 +31,8 @@ import (
 // Argument T is the number of Julian centuries since J2000.
 // See base.J2000Century.
 //
-// Results:
+// Results are in the range [0, 2π).
+//
 //	s = true geometric longitude, ☉
 //	ν = true anomaly
 func True(T float64) (s, ν unit.Angle) {
@@ -45,15 +46,59 @@ func True(T float64) (s, ν unit.Angle) {
 	return (L0 + C).Mod1(), (M + C).Mod1()
 }
 
+// TrueRate returns rates of change of the true geometric longitude and
+// true anomaly of the sun as returned by True.
+//
+// Argument T is the number of Julian centuries since J2000.
+// See base.J2000Century.
+//
+// Results are found by differentiating the expressions of True and are
+// per day.
+//
+//	ds = rate of change of true geometric longitude
+//	dν = rate of change of true anomaly, the angular velocity of the Sun
+//	     in its apparent orbit
+func TrueRate(T float64) (ds, dν unit.Angle) {
+	M := MeanAnomaly(T)
+	sM, cM := M.Sincos()
+	s2M, c2M := M.Mul(2).Sincos()
+	c3M := M.Mul(3).Cos()
+	// derivatives with respect to T, in degrees per century
+	dL0 := 36000.76983 + 2*0.0003032*T
+	dM := 35999.05029 - 2*0.0001537*T
+	dC := (-0.004817-2*.000014*T)*sM +
+		base.Horner(T, 1.914602, -0.004817, -.000014)*cM*dM*math.Pi/180 +
+		-.000101*s2M +
+		(0.019993-.000101*T)*2*c2M*dM*math.Pi/180 +
+		0.000289*3*c3M*dM*math.Pi/180
+	const d = 1. / base.JulianCentury
+	return unit.AngleFromDeg((dL0 + dC) * d), unit.AngleFromDeg((dM + dC) * d)
+}
+
+// ApparentLongitudeRate returns the rate of change of the apparent
+// longitude returned by ApparentLongitude.
+//
+// Argument T is the number of Julian centuries since J2000.
+// See base.J2000Century.
+//
+// Result is per day.
+func ApparentLongitudeRate(T float64) unit.Angle {
+	ds, _ := TrueRate(T)
+	// derivative of the nutation and aberration term of ApparentLongitude
+	return ds + unit.AngleFromDeg(.00478*1934.136*math.Pi/180/base.JulianCentury).
+		Mul(node(T).Cos())
+}
+
 // MeanAnomaly returns the mean anomaly of Earth at the given T.
 //
 // Argument T is the number of Julian centuries since J2000.
 // See base.J2000Century.
 //
-// Result is not normalized to the range 0..2π.
+// Result is in the range [0, 2π).
 func MeanAnomaly(T float64) unit.Angle {
 	// (25.3) p. 163
-	return unit.AngleFromDeg(base.Horner(T, 357.52911, 35999.05029, -0.0001537))
+	return unit.AngleFromDeg(base.Horner(T,
+		357.52911, 35999.05029, -0.0001537)).Mod1()
 }
 
 // Eccentricity returns eccentricity of the Earth's orbit around the sun.
@@ -82,12 +127,13 @@ func Radius(T float64) float64 {
 // Argument T is the number of Julian centuries since J2000.
 // See base.J2000Century.
 //
-// Result includes correction for nutation and aberration.
+// Result includes correction for nutation and aberration.  It is in the
+// range [0, 2π).
 func ApparentLongitude(T float64) unit.Angle {
 	Ω := node(T)
 	s, _ := True(T)
-	return s - unit.AngleFromDeg(.00569) -
-		unit.AngleFromDeg(.00478).Mul(Ω.Sin())
+	return (s - unit.AngleFromDeg(.00569) -
+		unit.AngleFromDeg(.00478).Mul(Ω.Sin())).Mod1()
 }
 
 func node(T float64) unit.Angle {
@@ -99,14 +145,14 @@ func node(T float64) unit.Angle {
 // Argument T is the number of Julian centuries since J2000.
 // See base.J2000Century.
 //
-// Results are accurate to .01 degree for years 1900 to 2100.
+// Results are accurate to .01 degree for years 1900 to 2100.  They are
+// in the range [0, 2π).
 //
-// Results:
 //	s = true geometric longitude, ☉
 //	ν = true anomaly
 func True2000(T float64) (s, ν unit.Angle) {
 	s, ν = True(T)
-	s -= unit.AngleFromDeg(.01397).Mul(T * 100)
+	s = (s - unit.AngleFromDeg(.01397).Mul(T*100)).Mod1()
 	return
 }
 
@@ -144,7 +190,7 @@ func ApparentEquatorial(jde float64) (α unit.RA, δ unit.Angle) {
 // Result computed by full VSOP87 theory.  Result is at equator and equinox
 // of date in the FK5 frame.  It does not include nutation or aberration.
 //
-//	s: ecliptic longitude
+//	s: ecliptic longitude, in the range [0, 2π)
 //	β: ecliptic latitude
 //	R: range in AU
 func TrueVSOP87(e *pp.V87Planet, jde float64) (s, β unit.Angle, R float64) {
@@ -165,7 +211,7 @@ func TrueVSOP87(e *pp.V87Planet, jde float64) (s, β unit.Angle, R float64) {
 // Result computed by VSOP87, at equator and equinox of date in the FK5 frame,
 // and includes effects of nutation and aberration.
 //
-//  λ: ecliptic longitude
+//  λ: ecliptic longitude, in the range [0, 2π)
 //  β: ecliptic latitude
 //  R: range in AU
 func ApparentVSOP87(e *pp.V87Planet, jde float64) (λ, β unit.Angle, R float64) {
@@ -173,7 +219,7 @@ func ApparentVSOP87(e *pp.V87Planet, jde float64) (λ, β unit.Angle, R float64)
 	s, β, R := TrueVSOP87(e, jde)
 	Δψ, _ := nutation.Nutation(jde)
 	a := aberration(R)
-	return s + Δψ + a, β, R
+	return (s + Δψ + a).Mod1(), β, R
 }
 
 // ApparentEquatorialVSOP87 returns the apparent position of the sun as equatorial coordinates.
@@ -205,3 +251,61 @@ func aberration(R float64) unit.Angle {
 	// (25.10) p. 167
 	return unit.AngleFromSec(-20.4898).Div(R)
 }
+
+// DiskOrientation returns the apparent orientation of the Sun at the given
+// jd, given the true geometric longitude s and radius vector R of the Sun.
+//
+// This is the math of chapter 29, Ephemeris for Physical Observations of the
+// Sun.  See also package solardisk.
+//
+// Results:
+//	P:  Position angle of the solar north pole.
+//	B0: Heliographic latitude of the center of the solar disk.
+//	L0: Heliographic longitude of the center of the solar disk.
+func DiskOrientation(jd float64, s unit.Angle, R float64) (P, B0, L0 unit.Angle) {
+	θ := unit.Angle((jd - 2398220) * 2 * math.Pi / 25.38)
+	I := unit.AngleFromDeg(7.25)
+	K := unit.AngleFromDeg(73.6667) +
+		unit.AngleFromDeg(1.3958333).Mul((jd-2396758)/base.JulianCentury)
+
+	Δψ, Δε := nutation.Nutation(jd)
+	ε0 := nutation.MeanObliquity(jd)
+	ε := ε0 + Δε
+	λ := s - unit.AngleFromSec(20.4898).Div(R)
+	λp := λ + Δψ
+
+	sλK, cλK := (λ - K).Sincos()
+	sI, cI := I.Sincos()
+
+	tx := -(λp.Cos() * ε.Tan())
+	ty := -(cλK * I.Tan())
+	P = unit.Angle(math.Atan(tx) + math.Atan(ty))
+	B0 = unit.Angle(math.Asin(sλK * sI))
+	η := unit.Angle(math.Atan2(-sλK*cI, -cλK))
+	L0 = (η - θ).Mod1()
+	return
+}
+
+// Disk returns the apparent orientation of the Sun at the given jd.
+//
+// Disk is a lower accuracy alternative to solardisk.Ephemeris, not requiring
+// a V87Planet object.  The position of the Sun is that of True.
+//
+// Results are as for DiskOrientation.
+func Disk(jd float64) (P, B0, L0 unit.Angle) {
+	T := base.J2000Century(jd)
+	s, _ := True(T)
+	return DiskOrientation(jd, s, Radius(T))
+}
+
+// DiskSlice returns the results of Disk for each element of a series of
+// jds.
+func DiskSlice(jd []float64) (P, B0, L0 []unit.Angle) {
+	P = make([]unit.Angle, len(jd))
+	B0 = make([]unit.Angle, len(jd))
+	L0 = make([]unit.Angle, len(jd))
+	for i, j := range jd {
+		P[i], B0[i], L0[i] = Disk(j)
+	}
+	return
+}
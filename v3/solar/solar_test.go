@@ -5,6 +5,8 @@ package solar_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
 
 	"github.com/soniakeys/meeus/v3/base"
 	"github.com/soniakeys/meeus/v3/julian"
@@ -26,12 +28,52 @@ func ExampleTrue() {
 	// ☉:   199.90987
 }
 
+func ExampleTrueRate() {
+	// Date of example 25.a, p. 165.
+	jde := julian.CalendarGregorianToJD(1992, 10, 13)
+	ds, dν := solar.TrueRate(base.J2000Century(jde))
+	fmt.Printf("ds: %.6f°/day\n", ds.Deg())
+	fmt.Printf("dν: %.6f°/day\n", dν.Deg())
+	fmt.Printf("dλ: %.6f°/day\n",
+		solar.ApparentLongitudeRate(base.J2000Century(jde)).Deg())
+	// Output:
+	// ds: 0.990136°/day
+	// dν: 0.990089°/day
+	// dλ: 0.990136°/day
+}
+
+func TestTrueRate(t *testing.T) {
+	// compare to numerical derivatives
+	const h = 1e-6 // centuries
+	for _, T := range []float64{-2, -.5, -.072047, .3, 1.7} {
+		ds, dν := solar.TrueRate(T)
+		s1, ν1 := solar.True(T - h)
+		s2, ν2 := solar.True(T + h)
+		λ1 := solar.ApparentLongitude(T - h)
+		λ2 := solar.ApparentLongitude(T + h)
+		d := 2 * h * base.JulianCentury
+		for _, c := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"ds", ds.Rad(), (s2 - s1).Rad() / d},
+			{"dν", dν.Rad(), (ν2 - ν1).Rad() / d},
+			{"dλ", solar.ApparentLongitudeRate(T).Rad(), (λ2 - λ1).Rad() / d},
+		} {
+			if math.Abs(c.got-c.want) > 1e-9 {
+				t.Fatal(c.name, T, c.got, c.want)
+			}
+		}
+	}
+}
+
 func ExampleMeanAnomaly() {
 	// Example 25.a, p. 165.
 	T := base.J2000Century(julian.CalendarGregorianToJD(1992, 10, 13))
+	// Meeus gives -2241.00603; the result is normalized to 278.99397.
 	fmt.Printf("%.5f\n", solar.MeanAnomaly(T).Deg())
 	// Output:
-	// -2241.00603
+	// 278.99397
 }
 
 func ExampleEccentricity() {
@@ -68,3 +110,44 @@ func ExampleApparentEquatorial() {
 	// α: 13ʰ13ᵐ31ˢ.4
 	// δ: -7°47′6″
 }
+
+func ExampleDisk() {
+	// Example 29.a, p. 191.
+	P, B0, L0 := solar.Disk(2448908.50068)
+	fmt.Printf("P:  %.2f\n", P.Deg())
+	fmt.Printf("B0: %+.2f\n", B0.Deg())
+	fmt.Printf("L0: %.2f\n", L0.Deg())
+	// Output:
+	// P:  26.27
+	// B0: +5.99
+	// L0: 238.63
+}
+
+func ExampleDiskSlice() {
+	// Daily from the date of example 29.a, p. 191.
+	jd := []float64{2448908.50068, 2448909.50068, 2448910.50068}
+	P, B0, L0 := solar.DiskSlice(jd)
+	for i := range jd {
+		fmt.Printf("P: %.2f  B0: %+.2f  L0: %6.2f\n",
+			P[i].Deg(), B0[i].Deg(), L0[i].Deg())
+	}
+	// Output:
+	// P: 26.27  B0: +5.99  L0: 238.63
+	// P: 26.25  B0: +5.92  L0: 225.44
+	// P: 26.21  B0: +5.84  L0: 212.25
+}
+
+func TestLongitudeRange(t *testing.T) {
+	// Angles must be normalized over centuries either side of J2000, where
+	// L0 and M have accumulated many revolutions.
+	for T := -30.; T <= 30; T += .0137 {
+		s, ν := solar.True(T)
+		s2, ν2 := solar.True2000(T)
+		for i, a := range []float64{s.Rad(), ν.Rad(), s2.Rad(), ν2.Rad(),
+			solar.MeanAnomaly(T).Rad(), solar.ApparentLongitude(T).Rad()} {
+			if a < 0 || a >= 2*math.Pi {
+				t.Fatal(T, i, a)
+			}
+		}
+	}
+}
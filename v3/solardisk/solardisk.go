@@ -7,8 +7,6 @@ package solardisk
 import (
 	"math"
 
-	"github.com/soniakeys/meeus/v3/base"
-	"github.com/soniakeys/meeus/v3/nutation"
 	pp "github.com/soniakeys/meeus/v3/planetposition"
 	"github.com/soniakeys/meeus/v3/solar"
 	"github.com/soniakeys/unit"
@@ -21,28 +19,8 @@ import (
 //	B0: Heliographic latitude of the center of the solar disk.
 //	L0: Heliographic longitude of the center of the solar disk.
 func Ephemeris(jd float64, e *pp.V87Planet) (P, B0, L0 unit.Angle) {
-	θ := unit.Angle((jd - 2398220) * 2 * math.Pi / 25.38)
-	I := unit.AngleFromDeg(7.25)
-	K := unit.AngleFromDeg(73.6667) +
-		unit.AngleFromDeg(1.3958333).Mul((jd-2396758)/base.JulianCentury)
-
 	L, _, R := solar.TrueVSOP87(e, jd)
-	Δψ, Δε := nutation.Nutation(jd)
-	ε0 := nutation.MeanObliquity(jd)
-	ε := ε0 + Δε
-	λ := L - unit.AngleFromSec(20.4898).Div(R)
-	λp := λ + Δψ
-
-	sλK, cλK := (λ - K).Sincos()
-	sI, cI := I.Sincos()
-
-	tx := -(λp.Cos() * ε.Tan())
-	ty := -(cλK * I.Tan())
-	P = unit.Angle(math.Atan(tx) + math.Atan(ty))
-	B0 = unit.Angle(math.Asin(sλK * sI))
-	η := unit.Angle(math.Atan2(-sλK*cI, -cλK))
-	L0 = (η - θ).Mod1()
-	return
+	return solar.DiskOrientation(jd, L, R)
 }
 
 // Cycle returns the jd of the start of the given synodic rotation.
@@ -57,3 +35,65 @@ func Cycle(c int) (jde float64) {
 	s2m, c2m := math.Sincos(2 * m)
 	return jde + .1454*math.Sin(m) - .0085*s2m - .0141*c2m
 }
+
+// CarringtonRate is the sidereal rotation rate of the Carrington system of
+// heliographic longitude, one rotation in 25.38 days.  Units are radians per
+// day.
+var CarringtonRate = unit.Angle(2 * math.Pi / 25.38)
+
+// DiskPosition returns the position on the apparent solar disk of a point
+// with heliographic coordinates L, B.
+//
+// Arguments P, B0, L0 are the orientation of the Sun as returned by Ephemeris
+// or solar.Disk.
+//
+// Results:
+//	visible: true if the point is on the hemisphere facing the Earth.
+//	pa:      Position angle of the point from the center of the disk,
+//	         measured from celestial north through east.
+//	ρ:       Distance of the point from the center of the disk as a
+//	         fraction of the apparent radius.
+func DiskPosition(L, B, P, B0, L0 unit.Angle) (visible bool, pa unit.Angle, ρ float64) {
+	sB, cB := B.Sincos()
+	sB0, cB0 := B0.Sincos()
+	sΔL, cΔL := (L - L0).Sincos()
+	// x toward solar west, y toward solar north, z toward the observer.
+	x := cB * sΔL
+	y := sB*cB0 - cB*sB0*cΔL
+	z := sB*sB0 + cB*cB0*cΔL
+	visible = z > 0
+	pa = (P + unit.Angle(math.Atan2(-x, y))).Mod1()
+	ρ = math.Hypot(x, y)
+	return
+}
+
+// Position is the position of a feature on the solar disk at a time.
+//
+// Fields Visible, PA, and Rho are as the results of DiskPosition.
+type Position struct {
+	JD      float64
+	Visible bool
+	PA      unit.Angle
+	Rho     float64
+}
+
+// Track predicts the disk positions of a feature such as a sunspot at a
+// series of times.
+//
+// Arguments L, B are the heliographic coordinates of the feature at time
+// jd0.  Argument rate is the sidereal rotation rate of the feature per day.
+// Pass CarringtonRate to hold L fixed, or a rate for the latitude of the
+// feature to allow for differential rotation.  Argument jd is the series of
+// times.
+//
+// The orientation of the Sun at each time is computed with solar.Disk.
+func Track(jd0 float64, L, B, rate unit.Angle, jd []float64) []Position {
+	tr := make([]Position, len(jd))
+	for i, j := range jd {
+		P, B0, L0 := solar.Disk(j)
+		Lj := L + (rate - CarringtonRate).Mul(j-jd0)
+		tr[i].JD = j
+		tr[i].Visible, tr[i].PA, tr[i].Rho = DiskPosition(Lj, B, P, B0, L0)
+	}
+	return tr
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/soniakeys/meeus/v3/julian"
+	"github.com/soniakeys/meeus/v3/solar"
 	"github.com/soniakeys/meeus/v3/solardisk"
 )
 
@@ -20,3 +21,27 @@ func ExampleCycle() {
 	// 2444480.7230
 	// 1980 August 29.22
 }
+
+func ExampleTrack() {
+	// A feature at the center of the disk at the time of example 29.a,
+	// p. 191, followed across the disk at daily intervals.
+	j0 := 2448908.50068
+	_, B0, L0 := solar.Disk(j0)
+	jd := make([]float64, 8)
+	for i := range jd {
+		jd[i] = j0 + float64(i+1)
+	}
+	for _, p := range solardisk.Track(j0, L0, B0, solardisk.CarringtonRate, jd) {
+		fmt.Printf("%.1f  %-5t  PA %5.1f°  ρ %.3f\n",
+			p.JD-j0, p.Visible, p.PA.Deg(), p.Rho)
+	}
+	// Output:
+	// 1.0  true   PA 297.2°  ρ 0.227
+	// 2.0  true   PA 297.9°  ρ 0.442
+	// 3.0  true   PA 298.6°  ρ 0.634
+	// 4.0  true   PA 299.3°  ρ 0.793
+	// 5.0  true   PA 300.1°  ρ 0.910
+	// 6.0  true   PA 301.0°  ρ 0.981
+	// 7.0  false  PA 302.1°  ρ 1.000
+	// 8.0  false  PA 303.5°  ρ 0.967
+}
@@ -7,7 +7,10 @@ package solarxyz_test
 
 import (
 	"fmt"
+	"math"
+	"testing"
 
+	"github.com/soniakeys/meeus/v3/base"
 	pp "github.com/soniakeys/meeus/v3/planetposition"
 	"github.com/soniakeys/meeus/v3/solarxyz"
 )
@@ -85,3 +88,60 @@ func ExamplePositionEquinox() {
 	// Y0 = -0.32237347
 	// Z0 = -0.13977803
 }
+
+func ExamplePlanet() {
+	// Venus on 1992 December 20, the date of example 33.a, p. 225.
+	// Expected values are the geometric positions of Venus and Earth given
+	// there for τ = 0, differenced and rotated to the equator of date.
+	e, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	v, err := pp.LoadPlanet(pp.Venus)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	x, y, z := solarxyz.Planet(e, v, 2448976.5)
+	fmt.Printf("X = %.5f\n", x)
+	fmt.Printf("Y = %.5f\n", y)
+	fmt.Printf("Z = %.5f\n", z)
+	fmt.Printf("Δ = %.5f\n", math.Sqrt(x*x+y*y+z*z))
+	// Output:
+	// X = 0.62175
+	// Y = -0.59677
+	// Z = -0.29486
+	// Δ = 0.91085
+}
+
+func TestPlanetJ2000(t *testing.T) {
+	e, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		t.Skip(err)
+	}
+	v, err := pp.LoadPlanet(pp.Venus)
+	if err != nil {
+		t.Skip(err)
+	}
+	// With the epoch J2000, PlanetEquinox is PlanetJ2000, and the Sun is
+	// the planet at the origin.
+	jde := 2448976.5
+	x, y, z := solarxyz.PlanetJ2000(e, v, jde)
+	xe, ye, ze := solarxyz.PlanetEquinox(e, v, jde, 2000)
+	if math.Abs(x-xe)+math.Abs(y-ye)+math.Abs(z-ze) > 1e-15 {
+		t.Fatal(x-xe, y-ye, z-ze)
+	}
+	// of date equals explicit epoch of date
+	xd, yd, zd := solarxyz.Planet(e, v, jde)
+	xe, ye, ze = solarxyz.PlanetEquinox(e, v, jde, base.JDEToJulianYear(jde))
+	if xd != xe || yd != ye || zd != ze {
+		t.Fatal(xd-xe, yd-ye, zd-ze)
+	}
+	// heliocentric distance recovered by removing the Sun
+	xs, ys, zs := solarxyz.PositionJ2000(e, jde)
+	_, _, r := v.Position2000(jde)
+	if d := math.Sqrt((x-xs)*(x-xs)+(y-ys)*(y-ys)+(z-zs)*(z-zs)) - r; math.Abs(d) > 1e-12 {
+		t.Fatal(d)
+	}
+}
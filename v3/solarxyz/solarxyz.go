@@ -36,7 +36,12 @@ func LongitudeJ2000(e *pp.V87Planet, jde float64) (l unit.Angle) {
 
 // PositionJ2000 returns rectangular coordinates referenced to equinox J2000.
 func PositionJ2000(e *pp.V87Planet, jde float64) (x, y, z float64) {
-	x, y, z = xyz(e, jde)
+	return toEquatorJ2000(xyz(e, jde))
+}
+
+// toEquatorJ2000 rotates rectangular coordinates from the VSOP87 ecliptic
+// to the FK5 equator, both of J2000.
+func toEquatorJ2000(x, y, z float64) (float64, float64, float64) {
 	// (26.3) p. 174
 	return x + .00000044036*y - .000000190919*z,
 		-.000000479966*x + .917482137087*y - .397776982902*z,
@@ -45,17 +50,53 @@ func PositionJ2000(e *pp.V87Planet, jde float64) (x, y, z float64) {
 
 func xyz(e *pp.V87Planet, jde float64) (x, y, z float64) {
 	l, b, r := e.Position2000(jde)
-	s := l + math.Pi
-	β := -b
-	ss, cs := s.Sincos()
-	sβ, cβ := β.Sincos()
+	return sphToRect(l+math.Pi, -b, r)
+}
+
+func sphToRect(l, b unit.Angle, r float64) (x, y, z float64) {
+	sl, cl := l.Sincos()
+	sb, cb := b.Sincos()
 	// (26.2) p. 172
-	x = r * cβ * cs
-	y = r * cβ * ss
-	z = r * sβ
+	x = r * cb * cl
+	y = r * cb * sl
+	z = r * sb
 	return
 }
 
+// PlanetJ2000 returns geocentric rectangular coordinates of a planet
+// referenced to the equator and equinox J2000.
+//
+// Argument e must be a V87Planet object for Earth, p for the planet.
+// The heliocentric position of the planet is rotated as in PositionJ2000
+// and added to the geocentric position of the Sun.
+//
+// Results are geometric positions in AU at time jde.  They do not include
+// light-time; for an astrometric position evaluate the planet at jde - τ as
+// in package elliptic.
+func PlanetJ2000(e, p *pp.V87Planet, jde float64) (x, y, z float64) {
+	x0, y0, z0 := PositionJ2000(e, jde)
+	l, b, r := p.Position2000(jde)
+	x, y, z = toEquatorJ2000(sphToRect(l, b, r))
+	return x + x0, y + y0, z + z0
+}
+
+// PlanetEquinox returns geocentric rectangular coordinates of a planet
+// referenced to the mean equator and equinox of an arbitrary epoch.
+//
+// Arguments are as for PlanetJ2000, with the position computed for jde but
+// referenced to mean equinox "epoch" (year), as for PositionEquinox.
+func PlanetEquinox(e, p *pp.V87Planet, jde, epoch float64) (x, y, z float64) {
+	return precessor(epoch)(PlanetJ2000(e, p, jde))
+}
+
+// Planet returns geocentric rectangular coordinates of a planet referenced
+// to the mean equator and equinox of date.
+//
+// Arguments and results are as for PlanetJ2000.
+func Planet(e, p *pp.V87Planet, jde float64) (x, y, z float64) {
+	return PlanetEquinox(e, p, jde, base.JDEToJulianYear(jde))
+}
+
 // PositionB1950 returns rectangular coordinates referenced to B1950.
 //
 // Results are referenced to the mean equator and equinox of the epoch B1950
@@ -78,7 +119,12 @@ var (
 // Position will be computed for given Julian day "jde" but referenced to mean
 // equinox "epoch" (year).
 func PositionEquinox(e *pp.V87Planet, jde, epoch float64) (xp, yp, zp float64) {
-	x0, y0, z0 := PositionJ2000(e, jde)
+	return precessor(epoch)(PositionJ2000(e, jde))
+}
+
+// precessor returns a function precessing rectangular equatorial
+// coordinates from J2000 to the mean equinox of epoch.
+func precessor(epoch float64) func(x0, y0, z0 float64) (xp, yp, zp float64) {
 	t := (epoch - 2000) * .01
 	ζ := base.Horner(t, ζt...) * t * math.Pi / 180 / 3600
 	z := base.Horner(t, zt...) * t * math.Pi / 180 / 3600
@@ -95,7 +141,9 @@ func PositionEquinox(e *pp.V87Planet, jde, epoch float64) (xp, yp, zp float64) {
 	zx := -cz * sθ
 	zy := -sz * sθ
 	zz := cθ
-	return xx*x0 + yx*y0 + zx*z0,
-		xy*x0 + yy*y0 + zy*z0,
-		xz*x0 + yz*y0 + zz*z0
+	return func(x0, y0, z0 float64) (xp, yp, zp float64) {
+		return xx*x0 + yx*y0 + zx*z0,
+			xy*x0 + yy*y0 + zy*z0,
+			xz*x0 + yz*y0 + zz*z0
+	}
 }
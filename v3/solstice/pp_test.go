@@ -101,3 +101,19 @@ var years = []seasons{
 	{6500, 89.58, 92.96, 93.04, 89.66},
 }
 */
+
+func ExampleTime_vSOP87() {
+	// Example 27.b, p. 180.
+	e, err := pp.LoadPlanet(pp.Earth)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	j, m := solstice.Time(1962, solstice.JuneSolstice, e)
+	t := j - 2437836.5 // 0h 1962 June 21
+	fmt.Println(sexa.FmtTime(unit.TimeFromDay(t)))
+	fmt.Println(m == solstice.VSOP87)
+	// Output:
+	// 21ʰ24ᵐ42ˢ
+	// true
+}
@@ -200,3 +200,52 @@ func eq2(y int, e *pp.V87Planet, q unit.Angle, c []float64) float64 {
 	}
 	return J0
 }
+
+// Season identifies an equinox or solstice for function Time.
+type Season int
+
+// Season constants.
+const (
+	MarchEquinox Season = iota
+	JuneSolstice
+	SeptemberEquinox
+	DecemberSolstice
+)
+
+// Method identifies the method used by function Time.
+type Method int
+
+// Method constants.
+const (
+	Series Method = iota // the periodic terms of Table 27.C, p. 179
+	VSOP87               // refinement with the full VSOP87 theory
+)
+
+// parallel arrays indexed by Season
+var (
+	c0 = [4][]float64{mc0, jc0, sc0, dc0}
+	c2 = [4][]float64{mc2, jc2, sc2, dc2}
+)
+
+// Time returns the JDE of an equinox or solstice for the given year.
+//
+// Argument s selects the equinox or solstice.  Argument e is optional.
+// If nil, the result is computed as with March, June, September, and
+// December and is accurate within one minute for the years 1951-2050.
+// If e is a V87Planet object representing Earth, the result is computed as
+// with March2, June2, September2, and December2 and is accurate to one
+// second of time.
+//
+// Result method indicates which of the two methods was used.
+func Time(y int, s Season, e *pp.V87Planet) (jde float64, method Method) {
+	c := c2[s]
+	if y < 1000 {
+		c = c0[s]
+	} else {
+		y -= 2000
+	}
+	if e == nil {
+		return eq(y, c), Series
+	}
+	return eq2(y, e, unit.Angle(s)*math.Pi/2, c), VSOP87
+}
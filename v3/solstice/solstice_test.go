@@ -124,3 +124,28 @@ func Test2000(t *testing.T) {
 		}
 	}
 }
+
+func ExampleTime() {
+	// Example 27.a, p. 180, with no V87Planet available.
+	j, m := solstice.Time(1962, solstice.JuneSolstice, nil)
+	fmt.Printf("%.5f\n", j)
+	fmt.Println(m == solstice.Series)
+	// Output:
+	// 2437837.39245
+	// true
+}
+
+func TestTime(t *testing.T) {
+	for _, y := range []int{-500, 1962, 2025} {
+		for s, f := range []func(int) float64{
+			solstice.March, solstice.June,
+			solstice.September, solstice.December,
+		} {
+			j, m := solstice.Time(y, solstice.Season(s), nil)
+			if j != f(y) || m != solstice.Series {
+				t.Errorf("year %d season %d: got %.5f, want %.5f",
+					y, s, j, f(y))
+			}
+		}
+	}
+}
@@ -3,6 +3,94 @@
 
 // Sort: Chapter 6, Sorting Numbers.
 //
-// Documentation only.  The Go standard library has a nice sort package that
-// renders this chapter quite irrelevant.
+// The Go standard library has a nice sort package that renders most of this
+// chapter irrelevant.  What remains here is a small utility for the lists of
+// events produced by functions that enumerate phenomena such as phases,
+// eclipses, or planetary configurations.  Events are ordered by JDE, with ties
+// kept in their original order so that results are reproducible.
 package sort
+
+import (
+	"reflect"
+	gosort "sort"
+)
+
+// Event is an astronomical event at a dynamical time.
+//
+// JDE is the time of the event.  Data is any payload the caller wishes to
+// associate with the event, typically identifying the kind of event.
+type Event struct {
+	JDE  float64
+	Data interface{}
+}
+
+// Events sorts events in place by JDE.
+//
+// The sort is stable; events with equal JDE keep their original order.
+func Events(e []Event) {
+	gosort.SliceStable(e, func(i, j int) bool { return e[i].JDE < e[j].JDE })
+}
+
+// Merge merges two event lists, each already sorted by JDE.
+//
+// A new list is returned.  Where events of a and b have equal JDE, those
+// of a come first.
+func Merge(a, b []Event) []Event {
+	m := make([]Event, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if b[0].JDE < a[0].JDE {
+			m = append(m, b[0])
+			b = b[1:]
+		} else {
+			m = append(m, a[0])
+			a = a[1:]
+		}
+	}
+	m = append(m, a...)
+	return append(m, b...)
+}
+
+// Dedup removes duplicate events from a list sorted by JDE.
+//
+// An event is considered a duplicate if it is within tol days of the
+// previous event kept and has equal Data.  Data values are compared with
+// function equal.  If equal is nil, they are compared with ==, which is
+// meaningful only for comparable values; Data values of types that are not
+// comparable, such as slices, maps, and funcs, are then never equal.  The
+// first of a run of duplicates is kept.  Deduplication is done in place and
+// the shortened slice is returned.
+func Dedup(e []Event, tol float64, equal func(a, b interface{}) bool) []Event {
+	if len(e) == 0 {
+		return e
+	}
+	if equal == nil {
+		equal = comparableEqual
+	}
+	r := e[:1]
+	for _, ev := range e[1:] {
+		dup := false
+		for i := len(r) - 1; i >= 0 && ev.JDE-r[i].JDE <= tol; i-- {
+			if equal(r[i].Data, ev.Data) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			r = append(r, ev)
+		}
+	}
+	return r
+}
+
+// comparableEqual compares a and b with ==, returning false rather than
+// panicking for values of types that are not comparable.
+func comparableEqual(a, b interface{}) bool {
+	ta := reflect.TypeOf(a)
+	if ta != reflect.TypeOf(b) {
+		return false
+	}
+	if ta != nil && !ta.Comparable() {
+		return false
+	}
+	return a == b
+}
@@ -0,0 +1,58 @@
+// Copyright 2013 Sonia Keys
+// License: MIT
+
+package sort_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/soniakeys/meeus/v3/sort"
+)
+
+func ExampleEvents() {
+	e := []sort.Event{
+		{2451565.3, "full moon"},
+		{2451550.26, "new moon"},
+		{2451565.3, "eclipse"},
+		{2451557.5, "first quarter"},
+	}
+	sort.Events(e)
+	for _, ev := range e {
+		fmt.Println(ev.JDE, ev.Data)
+	}
+	// Output:
+	// 2.45155026e+06 new moon
+	// 2.4515575e+06 first quarter
+	// 2.4515653e+06 full moon
+	// 2.4515653e+06 eclipse
+}
+
+func ExampleDedup() {
+	a := []sort.Event{{10, "a"}, {20, "a"}}
+	b := []sort.Event{{10.001, "a"}, {10.002, "b"}, {30, "a"}}
+	e := sort.Dedup(sort.Merge(a, b), .01, nil)
+	for _, ev := range e {
+		fmt.Println(ev.JDE, ev.Data)
+	}
+	// Output:
+	// 10 a
+	// 10.002 b
+	// 20 a
+	// 30 a
+}
+
+func TestDedupNotComparable(t *testing.T) {
+	// slices are not comparable; with a nil equal they are never
+	// duplicates, with an equal function they may be.
+	e := []sort.Event{{10, []int{1}}, {10, []int{1}}, {10, []int{2}}}
+	if r := sort.Dedup(append([]sort.Event{}, e...), .01, nil); len(r) != 3 {
+		t.Fatal("nil equal:", r)
+	}
+	equal := func(a, b interface{}) bool {
+		return a.([]int)[0] == b.([]int)[0]
+	}
+	if r := sort.Dedup(e, .01, equal); len(r) != 2 {
+		t.Fatal("equal func:", r)
+	}
+}
@@ -52,3 +52,46 @@ func AbsoluteByParallax(m float64, π unit.Angle) float64 {
 func AbsoluteByDistance(m, d float64) float64 {
 	return m + 5 - 5*math.Log10(d)
 }
+
+// ApparentByDistance returns apparent magnitude given absolute magnitude
+// and distance.
+//
+// Argument M is absolute magnitude, d is distance in parsecs.
+func ApparentByDistance(M, d float64) float64 {
+	return M - 5 + 5*math.Log10(d)
+}
+
+// DistanceModulus returns the distance modulus m - M, given distance d in
+// parsecs.
+func DistanceModulus(d float64) float64 {
+	return 5*math.Log10(d) - 5
+}
+
+// DistanceByModulus returns distance in parsecs given the distance
+// modulus m - M.
+func DistanceByModulus(mM float64) float64 {
+	return math.Pow(10, mM*.2+1)
+}
+
+// Temperature returns the effective temperature of a star, in Kelvins,
+// given its B-V color index.
+//
+// The formula is that of Ballesteros, "New insights into black bodies",
+// EPL 97 (2012), which treats the star as a black body.
+func Temperature(BV float64) float64 {
+	x := .92 * BV
+	return 4600 * (1/(x+1.7) + 1/(x+.62))
+}
+
+// ColorIndex returns the B-V color index of a star given its effective
+// temperature T in Kelvins.
+//
+// It is the inverse of Temperature.
+func ColorIndex(T float64) float64 {
+	// solve Temperature for x = .92 B-V, a quadratic in x.
+	k := T / 4600
+	b := 2.32*k - 2
+	c := 1.054*k - 2.32
+	x := (-b + math.Sqrt(b*b-4*k*c)) / (2 * k)
+	return x / .92
+}
@@ -56,3 +56,27 @@ func ExampleDifference() {
 	// Output:
 	// 6.75
 }
+
+func ExampleApparentByDistance() {
+	// Sirius, absolute magnitude 1.42 at 2.64 parsecs.
+	fmt.Printf("%.2f\n", stellar.ApparentByDistance(1.42, 2.64))
+	// Output:
+	// -1.47
+}
+
+func ExampleDistanceByModulus() {
+	// The Large Magellanic Cloud, distance modulus 18.5.
+	fmt.Printf("%.0f pc\n", stellar.DistanceByModulus(18.5))
+	// Output:
+	// 50119 pc
+}
+
+func ExampleTemperature() {
+	// The Sun, B-V = 0.65.
+	T := stellar.Temperature(.65)
+	fmt.Printf("%.0f K\n", T)
+	fmt.Printf("%.2f\n", stellar.ColorIndex(T))
+	// Output:
+	// 5778 K
+	// 0.65
+}
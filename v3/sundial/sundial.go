@@ -5,6 +5,8 @@
 package sundial
 
 import (
+	"fmt"
+	"io"
 	"math"
 
 	"github.com/soniakeys/unit"
@@ -192,3 +194,113 @@ func Vertical(φ, D unit.Angle, a float64) (lines []Line, center Point, u float6
 	u = a / math.Abs(cφ*cD)
 	return
 }
+
+// WriteSVG writes an SVG drawing of a sundial layout to w.
+//
+// Lines and center are results of one of the functions of this package.
+// Center may be nil, as for the results of Equatorial, or when the center
+// is not finite, as for a vertical dial facing due east or west.
+//
+// The drawing is in the plane of the sundial with the foot of the straight
+// stylus at the origin.  Coordinates are in the units of the sundial results,
+// with the y axis reversed as SVG coordinates increase downward.  Each hour
+// line is a path labeled with its hour.  The foot of the stylus and the
+// center are marked with small circles.  Strokes do not scale with the
+// drawing, so the drawing may be scaled as needed for fabrication.
+//
+// Hour lines near sunrise and sunset can extend to great distances.
+// Argument limit bounds the drawing: points farther than limit from the
+// foot of the stylus, in either coordinate, are left outside the viewBox
+// of the drawing and so are clipped.  A limit of 0 means no limit.
+func WriteSVG(w io.Writer, lines []Line, center *Point, limit float64) error {
+	in := func(p Point) bool {
+		return limit == 0 || math.Abs(p.X) <= limit && math.Abs(p.Y) <= limit
+	}
+	if center != nil && (math.IsInf(center.X, 0) || math.IsNaN(center.X) ||
+		math.IsInf(center.Y, 0) || math.IsNaN(center.Y)) {
+		center = nil
+	}
+	if center != nil && !in(*center) {
+		center = nil
+	}
+	// bounding box
+	x1, y1, x2, y2 := 0., 0., 0., 0.
+	box := func(p Point) {
+		if !in(p) {
+			return
+		}
+		x1 = math.Min(x1, p.X)
+		x2 = math.Max(x2, p.X)
+		y1 = math.Min(y1, -p.Y)
+		y2 = math.Max(y2, -p.Y)
+	}
+	for _, l := range lines {
+		for _, p := range l.Points {
+			box(p)
+		}
+	}
+	if center != nil {
+		box(*center)
+	}
+	m := .05 * math.Max(x2-x1, y2-y1)
+	if m == 0 {
+		m = 1
+	}
+	r := m / 5 // radius of marks, size of labels
+	if _, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" `+
+		`viewBox="%.4f %.4f %.4f %.4f">
+<g fill="none" stroke="black" stroke-width="1" vector-effect="non-scaling-stroke">
+`, x1-m, y1-m, x2-x1+2*m, y2-y1+2*m); err != nil {
+		return err
+	}
+	for _, l := range lines {
+		if _, err := fmt.Fprintf(w, `<path id="h%d" d="`, l.Hour); err != nil {
+			return err
+		}
+		c := 'M'
+		for _, p := range l.Points {
+			if _, err := fmt.Fprintf(w, "%c%.4f %.4f", c, p.X, -p.Y); err != nil {
+				return err
+			}
+			c = 'L'
+		}
+		if _, err := io.WriteString(w, "\"/>\n"); err != nil {
+			return err
+		}
+	}
+	mark := func(p Point) error {
+		_, err := fmt.Fprintf(w, `<circle cx="%.4f" cy="%.4f" r="%.4f"/>
+`, p.X, -p.Y, r)
+		return err
+	}
+	if err := mark(Point{}); err != nil {
+		return err
+	}
+	if center != nil {
+		if err := mark(*center); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, `</g>
+<g font-size="%.4f" text-anchor="middle">
+`, 2*r); err != nil {
+		return err
+	}
+	for _, l := range lines {
+		// label the outermost point within the limit
+		i := len(l.Points) - 1
+		for i >= 0 && !in(l.Points[i]) {
+			i--
+		}
+		if i < 0 {
+			continue
+		}
+		p := l.Points[i]
+		if _, err := fmt.Fprintf(w, `<text x="%.4f" y="%.4f">%d</text>
+`, p.X, -p.Y, l.Hour); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "</g>\n</svg>\n")
+	return err
+}
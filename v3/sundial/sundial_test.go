@@ -4,8 +4,10 @@
 package sundial_test
 
 import (
+	"bytes"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/soniakeys/meeus/v3/sundial"
 	"github.com/soniakeys/unit"
@@ -89,3 +91,28 @@ func ExampleGeneral_c() {
 	// Output:
 	// Hours:  5, 6, 13, 14, 15, 16, 17, 18, 19
 }
+
+func ExampleWriteSVG() {
+	// Horizontal sundial at latitude 40°, with a stylus 10 cm long.
+	// Dial coordinates, and so the SVG user units, are in cm.  The drawing
+	// is limited to 30 cm from the foot of the stylus.
+	ls, c, _ := sundial.Horizontal(unit.AngleFromDeg(40), 10)
+	var b bytes.Buffer
+	if err := sundial.WriteSVG(&b, ls, &c, 30); err != nil {
+		fmt.Println(err)
+		return
+	}
+	// show the start of the drawing and the noon line
+	s := strings.Split(b.String(), "\n")
+	fmt.Println(s[0])
+	fmt.Println(s[1])
+	for _, l := range s {
+		if strings.HasPrefix(l, `<path id="h12"`) {
+			fmt.Println(l)
+		}
+	}
+	// Output:
+	// <svg xmlns="http://www.w3.org/2000/svg" viewBox="-32.3256 -32.8513 64.6511 47.7075">
+	// <g fill="none" stroke="black" stroke-width="1" vector-effect="non-scaling-stroke">
+	// <path id="h12" d="M0.0000 -20.0044L0.0000 -17.4257L0.0000 -12.5582L0.0000 -8.3910L0.0000 -5.4363L0.0000 -3.6101L0.0000 -2.9735"/>
+}